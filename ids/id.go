@@ -176,3 +176,8 @@ func (id ID) MarshalText() ([]byte, error) {
 func (id ID) Compare(other ID) int {
 	return bytes.Compare(id[:], other[:])
 }
+
+// IsZero returns true if [id] is the all zero value.
+func (id ID) IsZero() bool {
+	return id == Empty
+}
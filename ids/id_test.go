@@ -328,3 +328,12 @@ func TestIDCompare(t *testing.T) {
 		})
 	}
 }
+
+func TestIDIsZero(t *testing.T) {
+	require := require.New(t)
+
+	require.True(Empty.IsZero())
+	require.True(ID{}.IsZero())
+	require.False(ID{1}.IsZero())
+	require.False(GenerateTestID().IsZero())
+}
@@ -70,6 +70,11 @@ func (id NodeID) Compare(other NodeID) int {
 	return bytes.Compare(id[:], other[:])
 }
 
+// ShortID returns the ShortID with the same bytes as [id].
+func (id NodeID) ShortID() ShortID {
+	return ShortID(id)
+}
+
 // ToNodeID attempt to convert a byte slice into a node id
 func ToNodeID(bytes []byte) (NodeID, error) {
 	nodeID, err := ToShortID(bytes)
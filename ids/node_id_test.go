@@ -216,3 +216,15 @@ func TestNodeIDCompare(t *testing.T) {
 		})
 	}
 }
+
+func TestNodeIDShortIDRoundTrip(t *testing.T) {
+	require := require.New(t)
+
+	nodeID := GenerateTestNodeID()
+	shortID := nodeID.ShortID()
+	require.Equal(nodeID.Bytes(), shortID.Bytes())
+	require.Equal(nodeID, shortID.NodeID())
+
+	shortID = GenerateTestShortID()
+	require.Equal(shortID, shortID.NodeID().ShortID())
+}
@@ -114,6 +114,16 @@ func (id ShortID) Compare(other ShortID) int {
 	return bytes.Compare(id[:], other[:])
 }
 
+// IsZero returns true if [id] is the all zero value.
+func (id ShortID) IsZero() bool {
+	return id == ShortEmpty
+}
+
+// NodeID returns the NodeID with the same bytes as [id].
+func (id ShortID) NodeID() NodeID {
+	return NodeID(id)
+}
+
 // ShortIDsToStrings converts an array of shortIDs to an array of their string
 // representations
 func ShortIDsToStrings(ids []ShortID) []string {
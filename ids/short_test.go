@@ -0,0 +1,19 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package ids
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestShortIDIsZero(t *testing.T) {
+	require := require.New(t)
+
+	require.True(ShortEmpty.IsZero())
+	require.True(ShortID{}.IsZero())
+	require.False(ShortID{1}.IsZero())
+	require.False(GenerateTestShortID().IsZero())
+}
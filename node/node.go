@@ -1200,6 +1200,12 @@ func (n *Node) initVMs() error {
 		vdrs = validators.NewManager()
 	}
 
+	// The P-chain only traces tx execution if tracing is enabled
+	var platformVMTracer trace.Tracer
+	if n.Config.TraceConfig.Enabled {
+		platformVMTracer = n.tracer
+	}
+
 	// Register the VMs that Avalanche supports
 	err := errors.Join(
 		n.VMManager.RegisterFactory(context.TODO(), constants.PlatformVMID, &platformvm.Factory{
@@ -1222,6 +1228,7 @@ func (n *Node) initVMs() error {
 				RewardConfig:              n.Config.RewardConfig,
 				UpgradeConfig:             n.Config.UpgradeConfig,
 				UseCurrentHeight:          n.Config.UseCurrentHeight,
+				Tracer:                    platformVMTracer,
 			},
 		}),
 		n.VMManager.RegisterFactory(context.TODO(), constants.AVMID, &avm.Factory{
@@ -86,7 +86,7 @@ func getParentFunc(g *voteVertex) func(id ids.ID) (ids.ID, bool) {
 				}
 			}
 		})
-		return result, result != ids.Empty
+		return result, !result.IsZero()
 	}
 }
 
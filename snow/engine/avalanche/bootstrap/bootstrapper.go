@@ -357,7 +357,7 @@ func (b *Bootstrapper) Start(ctx context.Context, startReqID uint32) error {
 	}
 
 	// If a stop vertex is well known, accept that.
-	if b.Config.StopVertexID != ids.Empty {
+	if !b.Config.StopVertexID.IsZero() {
 		b.Ctx.Log.Info("using well known stop vertex",
 			zap.Stringer("vtxID", b.Config.StopVertexID),
 		)
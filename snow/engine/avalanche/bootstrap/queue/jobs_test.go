@@ -31,13 +31,13 @@ func testJob(t *testing.T, jobID ids.ID, executed *bool, parentID ids.ID, parent
 			return jobID
 		},
 		MissingDependenciesF: func(context.Context) (set.Set[ids.ID], error) {
-			if parentID != ids.Empty && !*parentExecuted {
+			if !parentID.IsZero() && !*parentExecuted {
 				return set.Of(parentID), nil
 			}
 			return set.Set[ids.ID]{}, nil
 		},
 		HasMissingDependenciesF: func(context.Context) (bool, error) {
-			if parentID != ids.Empty && !*parentExecuted {
+			if !parentID.IsZero() && !*parentExecuted {
 				return true, nil
 			}
 			return false, nil
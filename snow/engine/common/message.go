@@ -35,3 +35,14 @@ func (msg Message) String() string {
 		return fmt.Sprintf("Unknown Message: %d", msg)
 	}
 }
+
+// DrainToEngine attempts to send [msg] on [ch] without blocking. Returns true
+// if the message was sent.
+func DrainToEngine(ch chan<- Message, msg Message) bool {
+	select {
+	case ch <- msg:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessageString(t *testing.T) {
+	tests := []struct {
+		msg      Message
+		expected string
+	}{
+		{
+			msg:      PendingTxs,
+			expected: "Pending Transactions",
+		},
+		{
+			msg:      StateSyncDone,
+			expected: "State Sync Done",
+		},
+		{
+			msg:      0,
+			expected: "Unknown Message: 0",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.expected, func(t *testing.T) {
+			require.Equal(t, test.expected, test.msg.String())
+		})
+	}
+}
+
+func TestDrainToEngine(t *testing.T) {
+	require := require.New(t)
+
+	ch := make(chan Message, 1)
+	require.True(DrainToEngine(ch, PendingTxs))
+	require.False(DrainToEngine(ch, StateSyncDone))
+	require.Equal(PendingTxs, <-ch)
+	require.Empty(ch)
+}
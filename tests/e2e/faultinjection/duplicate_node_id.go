@@ -24,7 +24,8 @@ var _ = ginkgo.Describe("Duplicate node handling", func() {
 	require := require.New(tc)
 
 	ginkgo.It("should ensure that a given Node ID (i.e. staking keypair) can be used at most once on a network", func() {
-		network := e2e.GetEnv(tc).GetNetwork()
+		network, err := e2e.GetEnv(tc).SafeGetNetwork()
+		require.NoError(err)
 
 		tc.By("creating new node")
 		node1 := e2e.AddEphemeralNode(tc, network, tmpnet.FlagsMap{})
@@ -44,9 +45,10 @@ var _ = ginkgo.Describe("Duplicate node handling", func() {
 			config.DataDirKey: fmt.Sprintf("%s-second", node1Flags[config.DataDirKey]),
 		}
 		node2 := e2e.AddEphemeralNode(tc, network, node2Flags)
+		e2e.RequireStakingKeysMatch(tc, node1, node2)
 
 		tc.By("checking that the second new node fails to become healthy before timeout")
-		err := tmpnet.WaitForHealthy(tc.DefaultContext(), node2)
+		err = tmpnet.WaitForHealthy(tc.DefaultContext(), node2)
 		require.ErrorIs(err, context.DeadlineExceeded)
 
 		tc.By("stopping the first new node")
@@ -23,7 +23,11 @@ import (
 	"github.com/ava-labs/avalanchego/vms/example/xsvm/cmd/issue/transfer"
 )
 
-const pollingInterval = 50 * time.Millisecond
+const (
+	pollingInterval = 50 * time.Millisecond
+
+	blksAcceptedMetric = "avalanche_snowman_blks_accepted_count"
+)
 
 var (
 	subnetAName = "xsvm-a"
@@ -54,7 +58,8 @@ var _ = ginkgo.Describe("[XSVM]", func() {
 	require := require.New(tc)
 
 	ginkgo.It("should support transfers between subnets", func() {
-		network := e2e.GetEnv(tc).GetNetwork()
+		network, err := e2e.GetEnv(tc).SafeGetNetwork()
+		require.NoError(err)
 
 		sourceSubnet := network.GetSubnet(subnetAName)
 		require.NotNil(sourceSubnet)
@@ -178,6 +183,54 @@ var _ = ginkgo.Describe("[XSVM]", func() {
 
 		_ = e2e.CheckBootstrapIsPossible(tc, network)
 	})
+
+	// Adding a subnet restarts the nodes of the shared network, which would
+	// disrupt tests running in parallel.
+	ginkgo.It("should support adding a subnet to a running network", ginkgo.Serial, func() {
+		env := e2e.GetEnv(tc)
+		network := env.GetNetwork()
+
+		key := e2e.NewPrivateKey(tc)
+		// Ensure a unique name so that the test can target a reused network
+		subnetName := fmt.Sprintf("xsvm-%d", time.Now().UnixNano())
+
+		tc.By(fmt.Sprintf("adding subnet %s to the network", subnetName))
+		require.NoError(env.AddSubnet(tc, subnet.NewXSVMOrPanic(subnetName, key, network.Nodes...)))
+
+		tc.By("checking that the subnet is visible via the network")
+		addedSubnet := env.GetNetwork().GetSubnet(subnetName)
+		require.NotNil(addedSubnet)
+		require.NotEqual(ids.Empty, addedSubnet.SubnetID)
+		require.Len(addedSubnet.Chains, 1)
+
+		tc.By("waiting for the subnet's validators to bootstrap its chain")
+		addedChain := addedSubnet.Chains[0]
+		require.NoError(env.WaitForChainBootstrap(tc, addedChain.ChainID))
+
+		tc.By("capturing a snapshot of the network's metrics")
+		snapshot, err := env.CaptureMetricSnapshot(tc)
+		require.NoError(err)
+
+		tc.By(fmt.Sprintf("issuing a transaction on chain %s to produce a block", addedChain.ChainID))
+		transferTxStatus, err := transfer.Transfer(
+			tc.DefaultContext(),
+			&transfer.Config{
+				URI:        env.GetRandomNodeURI().URI,
+				ChainID:    addedChain.ChainID,
+				AssetID:    addedChain.ChainID,
+				Amount:     units.Schmeckle,
+				To:         e2e.NewPrivateKey(tc).Address(),
+				PrivateKey: addedChain.PreFundedKey,
+			},
+		)
+		require.NoError(err)
+		tc.Log().Info("issued transfer transaction",
+			zap.Stringer("txID", transferTxStatus.TxID),
+		)
+
+		tc.By("checking that the accepted block count has increased")
+		env.AssertMetricIncreased(tc, blksAcceptedMetric, snapshot, 1)
+	})
 })
 
 // Retrieve the nodes corresponding to the provided IDs
@@ -4,9 +4,9 @@
 package e2e
 
 import (
-	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"math/rand"
 	"os"
 	"time"
@@ -15,13 +15,21 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 
+	"github.com/ava-labs/avalanchego/api/info"
 	"github.com/ava-labs/avalanchego/config"
+	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/tests"
 	"github.com/ava-labs/avalanchego/tests/fixture/tmpnet"
 	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 )
 
+var (
+	errNoTestEnvironment = errors.New("no test environment initialized; ensure tests are run by a suite that starts or reuses a network")
+	errNoNetworkDir      = errors.New("test environment does not specify a network dir")
+)
+
 // Env is used to access shared test fixture. Intended to be
 // initialized from SynchronizedBeforeSuite. Not exported to limit
 // access to the shared env to GetEnv which adds a test context.
@@ -94,9 +102,7 @@ func NewTestEnvironment(tc tests.TestContext, flagVars *FlagVars, desiredNetwork
 					tc.Log().Warn("unable to check that logs and metrics were collected from an uninitialized network")
 					return
 				}
-				ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
-				defer cancel()
-				require.NoError(tmpnet.CheckMonitoring(ctx, tc.Log(), network.UUID))
+				require.NoError(VerifyMonitoringIntegration(tc, network))
 			})
 		}
 	}
@@ -212,11 +218,101 @@ func (te *TestEnvironment) GetRandomNodeURI() tmpnet.NodeURI {
 
 // Retrieve the network to target for testing.
 func (te *TestEnvironment) GetNetwork() *tmpnet.Network {
-	network, err := tmpnet.ReadNetwork(te.NetworkDir)
+	network, err := te.SafeGetNetwork()
 	require.NoError(te.testContext, err)
 	return network
 }
 
+// SafeGetNetwork retrieves the network to target for testing. Unlike
+// GetNetwork, it returns an error rather than panicking if called on the nil
+// environment returned by GetEnv when no shared network was initialized.
+func (te *TestEnvironment) SafeGetNetwork() (*tmpnet.Network, error) {
+	if te == nil {
+		return nil, errNoTestEnvironment
+	}
+	if len(te.NetworkDir) == 0 {
+		return nil, errNoNetworkDir
+	}
+	return tmpnet.ReadNetwork(te.NetworkDir)
+}
+
+// AddSubnet creates the provided subnet on the shared network and restarts
+// nodes as needed for them to track it. Since the network is read from disk on
+// each call to GetNetwork, the subnet is subsequently visible via
+// GetNetwork().Subnets. The environment's URIs are refreshed to account for
+// restarted nodes being assigned new API ports.
+func (te *TestEnvironment) AddSubnet(tc tests.TestContext, subnet *tmpnet.Subnet) error {
+	network, err := te.SafeGetNetwork()
+	if err != nil {
+		return err
+	}
+	network.Subnets = append(network.Subnets, subnet)
+	err = network.CreateSubnets(
+		tc.DefaultContext(),
+		tc.Log(),
+		te.GetRandomNodeURI().URI,
+		true, /* restartRequired */
+	)
+	if err != nil {
+		network.Subnets = network.Subnets[:len(network.Subnets)-1]
+		return err
+	}
+	te.URIs = network.GetNodeURIs()
+	return nil
+}
+
+// WaitForChainBootstrap waits until every node validating [chainID] reports
+// that the chain is bootstrapped. For a chain not created by one of the
+// network's subnets (e.g. a primary network chain), all nodes are checked.
+func (te *TestEnvironment) WaitForChainBootstrap(tc tests.TestContext, chainID ids.ID) error {
+	network, err := te.SafeGetNetwork()
+	if err != nil {
+		return err
+	}
+
+	var validatorIDs set.Set[ids.NodeID]
+	for _, subnet := range network.Subnets {
+		for _, chain := range subnet.Chains {
+			if chain.ChainID == chainID {
+				validatorIDs.Add(subnet.ValidatorIDs...)
+			}
+		}
+	}
+	// Use the URIs of the network's nodes rather than the environment's URIs
+	// since the latter may be stale if nodes were restarted (e.g. by AddSubnet).
+	networkURIs := network.GetNodeURIs()
+	nodeURIs := make([]tmpnet.NodeURI, 0, len(networkURIs))
+	for _, nodeURI := range networkURIs {
+		if validatorIDs.Len() == 0 || validatorIDs.Contains(nodeURI.NodeID) {
+			nodeURIs = append(nodeURIs, nodeURI)
+		}
+	}
+
+	ctx := tc.DefaultContext()
+	ticker := time.NewTicker(DefaultPollingInterval)
+	defer ticker.Stop()
+	for _, nodeURI := range nodeURIs {
+		client := info.NewClient(nodeURI.URI)
+		for {
+			bootstrapped, err := client.IsBootstrapped(ctx, chainID.String())
+			if err == nil && bootstrapped {
+				tc.Log().Info("chain is bootstrapped",
+					zap.Stringer("chainID", chainID),
+					zap.Stringer("nodeID", nodeURI.NodeID),
+				)
+				break
+			}
+
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("failed to see chain %s bootstrapped on node %s before timeout: %w", chainID, nodeURI.NodeID, ctx.Err())
+			case <-ticker.C:
+			}
+		}
+	}
+	return nil
+}
+
 // Create a new keychain with the process's pre-funded key.
 func (te *TestEnvironment) NewKeychain() *secp256k1fx.Keychain {
 	return secp256k1fx.NewKeychain(te.PreFundedKey)
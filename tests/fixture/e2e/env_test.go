@@ -0,0 +1,21 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package e2e
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSafeGetNetwork(t *testing.T) {
+	require := require.New(t)
+
+	var nilEnv *TestEnvironment
+	_, err := nilEnv.SafeGetNetwork()
+	require.ErrorIs(err, errNoTestEnvironment)
+
+	_, err = (&TestEnvironment{}).SafeGetNetwork()
+	require.ErrorIs(err, errNoNetworkDir)
+}
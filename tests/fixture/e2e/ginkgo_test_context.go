@@ -12,6 +12,7 @@ import (
 	"go.uber.org/zap/zapcore"
 
 	"github.com/ava-labs/avalanchego/tests"
+	"github.com/ava-labs/avalanchego/tests/fixture/tmpnet"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
 )
@@ -52,6 +53,8 @@ func newGinkgoLogger(cfg zapcore.Encoder) logging.Logger {
 	)
 }
 
+var _ tests.Attacher = (*GinkgoTestContext)(nil)
+
 type GinkgoTestContext struct {
 	logger logging.Logger
 }
@@ -131,3 +134,11 @@ func (*GinkgoTestContext) Eventually(condition func() bool, waitFor time.Duratio
 		}
 	}
 }
+
+// Attach writes the artifact to a dir named for the current spec.
+func (tc *GinkgoTestContext) Attach(name string, data []byte, contentType string) {
+	testName := ginkgo.CurrentSpecReport().FullText()
+	if _, err := tmpnet.WriteArtifact(tc.logger, testName, name, data, contentType); err != nil {
+		tc.Errorf("failed to attach %q: %v", name, err)
+	}
+}
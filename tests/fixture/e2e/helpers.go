@@ -161,6 +161,21 @@ func WaitForHealthy(t require.TestingT, node *tmpnet.Node) {
 	require.NoError(t, tmpnet.WaitForHealthy(ctx, node))
 }
 
+// RequireStakingKeysMatch fails the test if the provided nodes are not
+// configured with the same staking certificate. The certificate determines a
+// node's ID, so matching certificates imply the nodes share a node ID.
+func RequireStakingKeysMatch(tc tests.TestContext, node1 *tmpnet.Node, node2 *tmpnet.Node) {
+	require := require.New(tc)
+
+	node1Cert, err := node1.Flags.GetStringVal(config.StakingCertContentKey)
+	require.NoError(err)
+	require.NotEmpty(node1Cert, "node %s is missing a staking certificate", node1.NodeID)
+
+	node2Cert, err := node2.Flags.GetStringVal(config.StakingCertContentKey)
+	require.NoError(err)
+	require.Equal(node1Cert, node2Cert, "staking certificates of nodes %s and %s do not match", node1.NodeID, node2.NodeID)
+}
+
 // Sends an eth transaction and waits for the transaction receipt from the
 // execution of the transaction.
 func SendEthTransaction(tc tests.TestContext, ethClient ethclient.Client, signedTx *types.Transaction) *types.Receipt {
@@ -389,3 +404,13 @@ func GetLocalStakingAddress(tc tests.TestContext, node *tmpnet.Node) netip.AddrP
 	tc.DeferCleanup(cancel)
 	return stakingAddress
 }
+
+// VerifyMonitoringIntegration checks that the logs and metrics of the provided
+// network were collected. The error is returned rather than failing the test so
+// that the caller determines how it is reported.
+func VerifyMonitoringIntegration(tc tests.TestContext, network *tmpnet.Network) error {
+	// Need to use explicit context (vs DefaultContext()) to support use with DeferCleanup
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	defer cancel()
+	return tmpnet.CheckMonitoring(ctx, tc.Log(), network.UUID)
+}
@@ -0,0 +1,103 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package e2e
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs/fee"
+	"github.com/ava-labs/avalanchego/wallet/chain/p/builder"
+)
+
+func TestGetRepoRootPath(t *testing.T) {
+	tests := []struct {
+		name        string
+		suffix      string
+		expectGoMod bool
+	}{
+		{
+			name:        "correct suffix",
+			suffix:      "tests/fixture/e2e",
+			expectGoMod: true,
+		},
+		{
+			name:        "incorrect suffix",
+			suffix:      "tests/fixture/not-e2e",
+			expectGoMod: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			// go test executes with the package directory as the working directory
+			repoRoot, err := GetRepoRootPath(test.suffix)
+			require.NoError(err)
+
+			info, err := os.Stat(repoRoot)
+			require.NoError(err)
+			require.True(info.IsDir())
+
+			_, err = os.Stat(filepath.Join(repoRoot, "go.mod"))
+			if test.expectGoMod {
+				require.NoError(err)
+			} else {
+				require.ErrorIs(err, os.ErrNotExist)
+			}
+		})
+	}
+}
+
+func TestNewPChainFeeCalculatorFromContext(t *testing.T) {
+	weights := gas.Dimensions{
+		gas.Bandwidth: 1,
+		gas.DBRead:    1,
+		gas.DBWrite:   1,
+		gas.Compute:   1,
+	}
+	tests := []struct {
+		name         string
+		context      *builder.Context
+		expectedType fee.Calculator
+	}{
+		{
+			name: "static",
+			context: &builder.Context{
+				ComplexityWeights: weights,
+			},
+			expectedType: &fee.SimpleCalculator{},
+		},
+		{
+			name: "dynamic",
+			context: &builder.Context{
+				ComplexityWeights: weights,
+				GasPrice:          gas.Price(units.NanoAvax),
+			},
+			expectedType: fee.NewDynamicCalculator(gas.Dimensions{}, 0),
+		},
+	}
+
+	tx := &txs.BaseTx{}
+	fees := make(map[string]uint64, len(tests))
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			calculator := NewPChainFeeCalculatorFromContext(test.context)
+			require.IsType(test.expectedType, calculator)
+
+			txFee, err := calculator.CalculateFee(tx)
+			require.NoError(err)
+			fees[test.name] = txFee
+		})
+	}
+	require.NotEqual(t, fees["static"], fees["dynamic"])
+}
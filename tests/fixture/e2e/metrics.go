@@ -0,0 +1,111 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package e2e
+
+import (
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/tests"
+)
+
+var errInsufficientMetricIncrease = errors.New("metric did not increase sufficiently")
+
+// GetMetrics retrieves the metrics of every running and healthy node of the
+// shared network and returns, for each metric name, the sum of the values
+// reported by those nodes across all label combinations. Only counter and
+// gauge metrics are included.
+func (te *TestEnvironment) GetMetrics(tc tests.TestContext) (map[string]float64, error) {
+	network, err := te.SafeGetNetwork()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := tc.DefaultContext()
+	nodeURIs := make([]string, 0, len(network.Nodes))
+	for _, node := range network.Nodes {
+		if node.IsEphemeral {
+			continue
+		}
+		// Checking health also ensures that the node's URI is current
+		healthy, err := node.IsHealthy(ctx)
+		if err != nil || !healthy {
+			tc.Log().Info("skipping metrics of unhealthy node",
+				zap.Stringer("nodeID", node.NodeID),
+				zap.Error(err),
+			)
+			continue
+		}
+		nodeURIs = append(nodeURIs, node.URI)
+	}
+	nodesMetrics, err := tests.GetNodesMetrics(ctx, nodeURIs)
+	if err != nil {
+		return nil, err
+	}
+	return sumNodesMetrics(nodesMetrics), nil
+}
+
+// sumNodesMetrics sums the values of the counter and gauge metrics of the
+// provided nodes by metric name.
+func sumNodesMetrics(nodesMetrics tests.NodesMetrics) map[string]float64 {
+	sums := make(map[string]float64)
+	for _, nodeMetrics := range nodesMetrics {
+		for name, metricFamily := range nodeMetrics {
+			for _, metric := range metricFamily.Metric {
+				switch {
+				case metric.Gauge != nil:
+					sums[name] += metric.Gauge.GetValue()
+				case metric.Counter != nil:
+					sums[name] += metric.Counter.GetValue()
+				}
+			}
+		}
+	}
+	return sums
+}
+
+// CaptureMetricSnapshot returns the current metrics of the shared network for
+// later comparison by AssertMetricIncreased.
+func (te *TestEnvironment) CaptureMetricSnapshot(tc tests.TestContext) (map[string]float64, error) {
+	return te.GetMetrics(tc)
+}
+
+// AssertMetricIncreased waits until the value of [metricName] reported by
+// GetMetrics exceeds its value in [snapshot] by at least [minIncrease]. The
+// test fails if this is not observed before DefaultTimeout.
+func (te *TestEnvironment) AssertMetricIncreased(
+	tc tests.TestContext,
+	metricName string,
+	snapshot map[string]float64,
+	minIncrease float64,
+) {
+	tc.Eventually(func() bool {
+		metrics, err := te.GetMetrics(tc)
+		if err != nil {
+			tc.Log().Warn("failed to retrieve metrics",
+				zap.Error(err),
+			)
+			return false
+		}
+		return checkMetricIncreased(metricName, snapshot, metrics, minIncrease) == nil
+	}, DefaultTimeout, DefaultPollingInterval, fmt.Sprintf("failed to see %s increase by %f before timeout", metricName, minIncrease))
+}
+
+// checkMetricIncreased returns an error if the value of [metricName] in
+// [current] does not exceed its value in [snapshot] by at least
+// [minIncrease]. A metric missing from a snapshot is treated as zero.
+func checkMetricIncreased(metricName string, snapshot map[string]float64, current map[string]float64, minIncrease float64) error {
+	increase := current[metricName] - snapshot[metricName]
+	if increase < minIncrease {
+		return fmt.Errorf("%w: %s increased by %f, expected at least %f",
+			errInsufficientMetricIncrease,
+			metricName,
+			increase,
+			minIncrease,
+		)
+	}
+	return nil
+}
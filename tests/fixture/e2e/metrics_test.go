@@ -0,0 +1,93 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package e2e
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/tests"
+
+	dto "github.com/prometheus/client_model/go"
+)
+
+func newCounter(value float64) *dto.Metric {
+	return &dto.Metric{
+		Counter: &dto.Counter{Value: &value},
+	}
+}
+
+func newGauge(value float64) *dto.Metric {
+	return &dto.Metric{
+		Gauge: &dto.Gauge{Value: &value},
+	}
+}
+
+func TestSumNodesMetrics(t *testing.T) {
+	require := require.New(t)
+
+	nodesMetrics := tests.NodesMetrics{
+		"node0": {
+			"counter": {Metric: []*dto.Metric{newCounter(1), newCounter(2)}},
+			"gauge":   {Metric: []*dto.Metric{newGauge(5)}},
+			"summary": {Metric: []*dto.Metric{{Summary: &dto.Summary{}}}},
+		},
+		"node1": {
+			"counter": {Metric: []*dto.Metric{newCounter(4)}},
+		},
+	}
+	require.Equal(
+		map[string]float64{
+			"counter": 7,
+			"gauge":   5,
+		},
+		sumNodesMetrics(nodesMetrics),
+	)
+}
+
+func TestCheckMetricIncreased(t *testing.T) {
+	const metricName = "avalanche_snowman_blks_accepted_count"
+
+	tests := []struct {
+		name        string
+		snapshot    map[string]float64
+		current     map[string]float64
+		minIncrease float64
+		expectedErr error
+	}{
+		{
+			name:        "sufficient increase",
+			snapshot:    map[string]float64{metricName: 1},
+			current:     map[string]float64{metricName: 3},
+			minIncrease: 2,
+		},
+		{
+			name:        "insufficient increase",
+			snapshot:    map[string]float64{metricName: 1},
+			current:     map[string]float64{metricName: 2},
+			minIncrease: 2,
+			expectedErr: errInsufficientMetricIncrease,
+		},
+		{
+			name:        "metric missing from snapshot",
+			snapshot:    map[string]float64{},
+			current:     map[string]float64{metricName: 1},
+			minIncrease: 1,
+		},
+		{
+			name:        "metric missing from current",
+			snapshot:    map[string]float64{metricName: 1},
+			current:     map[string]float64{},
+			minIncrease: 1,
+			expectedErr: errInsufficientMetricIncrease,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := checkMetricIncreased(metricName, test.snapshot, test.current, test.minIncrease)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package tmpnet
+
+import (
+	"fmt"
+	"mime"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/perms"
+)
+
+// Name of the dir under the root dir that stores test artifacts
+const ArtifactsDirName = "artifacts"
+
+var unsafePathChars = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
+// GetArtifactsDir returns the dir in which artifacts for the named test are
+// stored: [root dir]/artifacts/[testName].
+func GetArtifactsDir(testName string) (string, error) {
+	rootDir, err := GetRootDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(rootDir, ArtifactsDirName, unsafePathChars.ReplaceAllString(testName, "_")), nil
+}
+
+// WriteArtifact writes the provided data to the artifacts dir of the named
+// test and returns the path of the written file. If [name] has no extension,
+// the extension registered for [contentType] (if any) is appended.
+func WriteArtifact(
+	log logging.Logger,
+	testName string,
+	name string,
+	data []byte,
+	contentType string,
+) (string, error) {
+	artifactsDir, err := GetArtifactsDir(testName)
+	if err != nil {
+		return "", fmt.Errorf("failed to determine artifacts dir: %w", err)
+	}
+	if err := os.MkdirAll(artifactsDir, perms.ReadWriteExecute); err != nil {
+		return "", fmt.Errorf("failed to create artifacts dir: %w", err)
+	}
+
+	fileName := unsafePathChars.ReplaceAllString(name, "_")
+	if len(filepath.Ext(fileName)) == 0 {
+		if exts, err := mime.ExtensionsByType(contentType); err == nil && len(exts) > 0 {
+			fileName += exts[0]
+		}
+	}
+	path := filepath.Join(artifactsDir, fileName)
+	if err := os.WriteFile(path, data, perms.ReadWrite); err != nil {
+		return "", fmt.Errorf("failed to write artifact: %w", err)
+	}
+	log.Info("attached test artifact",
+		zap.String("name", name),
+		zap.String("contentType", contentType),
+		zap.String("path", path),
+	)
+	return path, nil
+}
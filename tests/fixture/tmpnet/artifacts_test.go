@@ -0,0 +1,59 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package tmpnet
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+func TestWriteArtifact(t *testing.T) {
+	tests := []struct {
+		name             string
+		artifactName     string
+		contentType      string
+		expectedFileName string
+	}{
+		{
+			name:             "name with extension",
+			artifactName:     "genesis.json",
+			contentType:      "application/octet-stream",
+			expectedFileName: "genesis.json",
+		},
+		{
+			name:             "extension from content type",
+			artifactName:     "genesis",
+			contentType:      "application/json",
+			expectedFileName: "genesis.json",
+		},
+		{
+			name:             "unknown content type",
+			artifactName:     "block dump",
+			contentType:      "application/x-unknown",
+			expectedFileName: "block_dump",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			rootDir := t.TempDir()
+			t.Setenv(RootDirEnvName, rootDir)
+
+			data := []byte(`{"networkID": 88888}`)
+			path, err := WriteArtifact(logging.NoLog{}, "Test/Name", test.artifactName, data, test.contentType)
+			require.NoError(err)
+			require.Equal(filepath.Join(rootDir, ArtifactsDirName, "Test_Name", test.expectedFileName), path)
+
+			writtenData, err := os.ReadFile(path)
+			require.NoError(err)
+			require.Equal(data, writtenData)
+		})
+	}
+}
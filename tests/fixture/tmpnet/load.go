@@ -0,0 +1,335 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package tmpnet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+
+	pwallet "github.com/ava-labs/avalanchego/wallet/chain/p/wallet"
+)
+
+// Amount transferred by each transaction issued to generate load. The
+// transfer is to the issuing key so only the fee is consumed.
+const loadTxAmount = units.MicroAvax
+
+var (
+	errInvalidTxRate       = errors.New("txsPerSecond must be greater than zero")
+	errNoPreFundedKeys     = errors.New("no pre-funded keys available")
+	errInvalidPreFundedKey = errors.New("pre-funded key index out of range")
+	errNoRunningNodes      = errors.New("no running nodes available")
+	errInvalidSampleCount  = errors.New("sampleTxs must be greater than zero")
+	errTooManyFailedTxs    = errors.New("fewer than half of the sample transactions were accepted")
+)
+
+// StressTestResult summarizes the transactions issued by Network.StressTest.
+type StressTestResult struct {
+	// Number of transactions that were accepted
+	TxCount int
+	// Accepted transactions per second over the duration of the test
+	TPS float64
+	// Median time from issuance to acceptance
+	P50Latency time.Duration
+	// 99th percentile time from issuance to acceptance
+	P99Latency time.Duration
+	// Number of transactions that failed to be issued or accepted
+	ErrorCount int
+}
+
+// StressTest issues up to [txsPerSecond] P-Chain BaseTxs per second for
+// [duration] and reports the observed throughput and acceptance latency.
+//
+// Transactions are issued sequentially from a wallet for the first pre-funded
+// key, so the achieved rate will be lower than requested if acceptance takes
+// longer than 1/[txsPerSecond].
+func (n *Network) StressTest(
+	ctx context.Context,
+	log logging.Logger,
+	txsPerSecond int,
+	duration time.Duration,
+) (StressTestResult, error) {
+	if txsPerSecond <= 0 {
+		return StressTestResult{}, errInvalidTxRate
+	}
+
+	wallet, key, cancel, err := n.newLoadWallet(ctx, 0)
+	if err != nil {
+		return StressTestResult{}, err
+	}
+	defer cancel()
+
+	log.Info("starting stress test",
+		zap.Int("txsPerSecond", txsPerSecond),
+		zap.Duration("duration", duration),
+	)
+
+	ticker := time.NewTicker(time.Second / time.Duration(txsPerSecond))
+	defer ticker.Stop()
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	var (
+		start      = time.Now()
+		latencies  []time.Duration
+		errorCount int
+	)
+	for done := false; !done; {
+		select {
+		case <-ctx.Done():
+			return StressTestResult{}, fmt.Errorf("stress test did not complete before timeout: %w", ctx.Err())
+		case <-timer.C:
+			done = true
+			continue
+		case <-ticker.C:
+		}
+
+		_, latency, err := issueLoadTx(ctx, wallet, key)
+		if err != nil {
+			log.Debug("failed to issue stress test transaction",
+				zap.Error(err),
+			)
+			errorCount++
+			continue
+		}
+		latencies = append(latencies, latency)
+	}
+	elapsed := time.Since(start)
+
+	result := StressTestResult{
+		TxCount:    len(latencies),
+		TPS:        float64(len(latencies)) / elapsed.Seconds(),
+		ErrorCount: errorCount,
+	}
+	if len(latencies) > 0 {
+		slices.Sort(latencies)
+		result.P50Latency = latencyPercentile(latencies, 0.5)
+		result.P99Latency = latencyPercentile(latencies, 0.99)
+	}
+	log.Info("completed stress test",
+		zap.Int("txCount", result.TxCount),
+		zap.Float64("tps", result.TPS),
+		zap.Duration("p50Latency", result.P50Latency),
+		zap.Duration("p99Latency", result.P99Latency),
+		zap.Int("errorCount", result.ErrorCount),
+	)
+	return result, nil
+}
+
+// GetConsensusLatency issues [sampleTxs] P-Chain BaseTxs one at a time and
+// returns the median time from issuance to acceptance. An error is returned if
+// fewer than half of the transactions are accepted.
+func (n *Network) GetConsensusLatency(ctx context.Context, sampleTxs int) (time.Duration, error) {
+	if sampleTxs <= 0 {
+		return 0, errInvalidSampleCount
+	}
+
+	wallet, key, cancel, err := n.newLoadWallet(ctx, 0)
+	if err != nil {
+		return 0, err
+	}
+	defer cancel()
+
+	latencies := make([]time.Duration, 0, sampleTxs)
+	for i := 0; i < sampleTxs; i++ {
+		_, latency, err := issueLoadTx(ctx, wallet, key)
+		if err != nil {
+			if ctx.Err() != nil {
+				return 0, fmt.Errorf("failed to measure consensus latency before timeout: %w", ctx.Err())
+			}
+			continue
+		}
+		latencies = append(latencies, latency)
+	}
+	if 2*len(latencies) < sampleTxs {
+		return 0, fmt.Errorf("%w: %d of %d accepted", errTooManyFailedTxs, len(latencies), sampleTxs)
+	}
+
+	slices.Sort(latencies)
+	return latencyPercentile(latencies, 0.5), nil
+}
+
+// LoadConfig configures the load generated by Network.GenerateLoad.
+type LoadConfig struct {
+	// Maximum number of transactions to issue per second
+	TPS int
+	// Index of the pre-funded key to issue transactions with
+	KeyIndex int
+	// How long to generate load for. If zero, load is generated until the
+	// context is cancelled.
+	Duration time.Duration
+}
+
+// LoadSample describes a transaction accepted by Network.GenerateLoad.
+type LoadSample struct {
+	TxID       ids.ID
+	IssuedAt   time.Time
+	AcceptedAt time.Time
+}
+
+// GenerateLoad starts issuing P-Chain BaseTxs in the background as configured
+// by [cfg] and reports each accepted transaction on the returned channel. The
+// channel is closed once the configured duration has elapsed or [ctx] is
+// cancelled. As for StressTest, transactions are issued sequentially so the
+// achieved rate may be lower than requested.
+func (n *Network) GenerateLoad(ctx context.Context, log logging.Logger, cfg LoadConfig) (<-chan LoadSample, error) {
+	if cfg.TPS <= 0 {
+		return nil, errInvalidTxRate
+	}
+
+	wallet, key, cancel, err := n.newLoadWallet(ctx, cfg.KeyIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		loadCtx    context.Context
+		cancelLoad context.CancelFunc
+	)
+	if cfg.Duration > 0 {
+		loadCtx, cancelLoad = context.WithTimeout(ctx, cfg.Duration)
+	} else {
+		loadCtx, cancelLoad = context.WithCancel(ctx)
+	}
+
+	log.Info("starting load generation",
+		zap.Int("tps", cfg.TPS),
+		zap.Int("keyIndex", cfg.KeyIndex),
+		zap.Duration("duration", cfg.Duration),
+	)
+
+	samples := make(chan LoadSample, cfg.TPS)
+	go func() {
+		defer func() {
+			close(samples)
+			cancelLoad()
+			cancel()
+		}()
+
+		ticker := time.NewTicker(time.Second / time.Duration(cfg.TPS))
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-loadCtx.Done():
+				log.Info("stopped load generation")
+				return
+			case <-ticker.C:
+			}
+
+			issuedAt := time.Now()
+			txID, latency, err := issueLoadTx(loadCtx, wallet, key)
+			if err != nil {
+				if loadCtx.Err() == nil {
+					log.Debug("failed to issue load transaction",
+						zap.Error(err),
+					)
+				}
+				continue
+			}
+
+			select {
+			case samples <- LoadSample{
+				TxID:       txID,
+				IssuedAt:   issuedAt,
+				AcceptedAt: issuedAt.Add(latency),
+			}:
+			case <-loadCtx.Done():
+				log.Info("stopped load generation")
+				return
+			}
+		}
+	}()
+	return samples, nil
+}
+
+// newLoadWallet returns a P-Chain wallet for the pre-funded key at [keyIndex]
+// connected to the first running node of the network. The returned function
+// must be called to release the node's URI once the wallet is no longer used.
+func (n *Network) newLoadWallet(ctx context.Context, keyIndex int) (pwallet.Wallet, *secp256k1.PrivateKey, func(), error) {
+	if len(n.PreFundedKeys) == 0 {
+		return nil, nil, nil, errNoPreFundedKeys
+	}
+	if keyIndex < 0 || keyIndex >= len(n.PreFundedKeys) {
+		return nil, nil, nil, fmt.Errorf("%w: %d", errInvalidPreFundedKey, keyIndex)
+	}
+
+	nodes := n.runningNodes()
+	if len(nodes) == 0 {
+		return nil, nil, nil, errNoRunningNodes
+	}
+	uri, cancel, err := nodes[0].GetLocalURI(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	key := n.PreFundedKeys[keyIndex]
+	keychain := secp256k1fx.NewKeychain(key)
+	wallet, err := primary.MakeWallet(
+		ctx,
+		uri,
+		keychain,
+		keychain,
+		primary.WalletConfig{},
+	)
+	if err != nil {
+		cancel()
+		return nil, nil, nil, fmt.Errorf("failed to create wallet: %w", err)
+	}
+	return wallet.P(), key, cancel, nil
+}
+
+// issueLoadTx issues a BaseTx transferring [loadTxAmount] back to [key] and
+// returns its ID and the time taken for it to be accepted.
+func issueLoadTx(ctx context.Context, wallet pwallet.Wallet, key *secp256k1.PrivateKey) (ids.ID, time.Duration, error) {
+	start := time.Now()
+	tx, err := wallet.IssueBaseTx(
+		[]*avax.TransferableOutput{{
+			Asset: avax.Asset{
+				ID: wallet.Builder().Context().AVAXAssetID,
+			},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: loadTxAmount,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs: []ids.ShortID{
+						key.Address(),
+					},
+				},
+			},
+		}},
+		common.WithContext(ctx),
+	)
+	if err != nil {
+		return ids.Empty, 0, err
+	}
+	return tx.ID(), time.Since(start), nil
+}
+
+// latencyPercentile returns the nearest-rank value of the [percentile]
+// (expressed as a fraction in (0, 1]) of the provided sorted latencies.
+func latencyPercentile(sortedLatencies []time.Duration, percentile float64) time.Duration {
+	if len(sortedLatencies) == 0 {
+		return 0
+	}
+	// The nearest rank is the smallest value such that at least [percentile]
+	// of the values are less than or equal to it.
+	rank := int(math.Ceil(float64(len(sortedLatencies)) * percentile))
+	rank = min(max(rank, 1), len(sortedLatencies))
+	return sortedLatencies[rank-1]
+}
@@ -0,0 +1,60 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package tmpnet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLatencyPercentile(t *testing.T) {
+	latencies := make([]time.Duration, 100)
+	for i := range latencies {
+		latencies[i] = time.Duration(i+1) * time.Millisecond
+	}
+
+	tests := []struct {
+		name       string
+		latencies  []time.Duration
+		percentile float64
+		expected   time.Duration
+	}{
+		{
+			name:       "no latencies",
+			percentile: 0.5,
+			expected:   0,
+		},
+		{
+			name:       "single latency",
+			latencies:  []time.Duration{time.Second},
+			percentile: 0.99,
+			expected:   time.Second,
+		},
+		{
+			name:       "median",
+			latencies:  latencies,
+			percentile: 0.5,
+			expected:   50 * time.Millisecond,
+		},
+		{
+			name:       "p99",
+			latencies:  latencies,
+			percentile: 0.99,
+			expected:   99 * time.Millisecond,
+		},
+		{
+			name:       "max",
+			latencies:  latencies,
+			percentile: 1,
+			expected:   100 * time.Millisecond,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, latencyPercentile(test.latencies, test.percentile))
+		})
+	}
+}
@@ -33,7 +33,6 @@ import (
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/perms"
 	"github.com/ava-labs/avalanchego/utils/set"
-	"github.com/ava-labs/avalanchego/vms/platformvm"
 )
 
 // The Network type is defined in this file (orchestration) and
@@ -56,6 +55,9 @@ const (
 	// All temporary networks will use this arbitrary network ID by default.
 	defaultNetworkID = 88888
 
+	// Format of the creation time that prefixes the name of a network dir
+	networkDirTimeFormat = "20060102-150405.999999"
+
 	// eth address: 0x8db97C7cEcE249c2b98bDC0226Cc4C2A57BF52FC
 	HardHatKeyStr = "56289e99c94b6912bfc12adc093c9b51124f0dc54ac7a766b2bc5ccf558d8027"
 )
@@ -66,6 +68,9 @@ var (
 	HardhatKey *secp256k1.PrivateKey
 
 	errInsufficientNodes = errors.New("at least one node is required")
+	errInvalidBatchSize  = errors.New("batch size must be greater than zero")
+
+	ErrCannotParseNetworkAge = errors.New("cannot parse network age")
 )
 
 func init() {
@@ -281,7 +286,7 @@ func (n *Network) Create(rootDir string) error {
 	}
 
 	// A time-based name ensures consistent directory ordering
-	dirName := time.Now().Format("20060102-150405.999999")
+	dirName := time.Now().Format(networkDirTimeFormat)
 	if len(n.Owner) > 0 {
 		// Include the owner to differentiate networks created at similar times
 		dirName = fmt.Sprintf("%s-%s", dirName, n.Owner)
@@ -517,6 +522,21 @@ func (n *Network) StartNode(ctx context.Context, log logging.Logger, node *Node)
 
 // Restart a single node.
 func (n *Network) RestartNode(ctx context.Context, log logging.Logger, node *Node) error {
+	if err := stopNodeForRestart(ctx, node); err != nil {
+		return err
+	}
+	if err := n.StartNode(ctx, log, node); err != nil {
+		return fmt.Errorf("failed to start node %s: %w", node.NodeID, err)
+	}
+	log.Info("waiting for node to report healthy",
+		zap.Stringer("nodeID", node.NodeID),
+	)
+	return WaitForHealthy(ctx, node)
+}
+
+// stopNodeForRestart stops the provided node, first saving its API port if it
+// should be reused when the node is restarted.
+func stopNodeForRestart(ctx context.Context, node *Node) error {
 	if node.RuntimeConfig.ReuseDynamicPorts {
 		// Attempt to save the API port currently being used so the
 		// restarted node can reuse it. This may result in the node
@@ -530,13 +550,7 @@ func (n *Network) RestartNode(ctx context.Context, log logging.Logger, node *Nod
 	if err := node.Stop(ctx); err != nil {
 		return fmt.Errorf("failed to stop node %s: %w", node.NodeID, err)
 	}
-	if err := n.StartNode(ctx, log, node); err != nil {
-		return fmt.Errorf("failed to start node %s: %w", node.NodeID, err)
-	}
-	log.Info("waiting for node to report healthy",
-		zap.Stringer("nodeID", node.NodeID),
-	)
-	return WaitForHealthy(ctx, node)
+	return nil
 }
 
 // Stops all nodes in the network.
@@ -580,6 +594,131 @@ func (n *Network) Restart(ctx context.Context, log logging.Logger) error {
 	return nil
 }
 
+// UpgradeNode restarts the specified node with the avalanchego binary at
+// [newBinaryPath] and waits for it to report healthy.
+func (n *Network) UpgradeNode(ctx context.Context, log logging.Logger, nodeID ids.NodeID, newBinaryPath string) error {
+	node, err := n.GetNode(nodeID)
+	if err != nil {
+		return err
+	}
+	if err := n.EnsureNodeConfig(node); err != nil {
+		return err
+	}
+
+	log.Info("upgrading node",
+		zap.Stringer("nodeID", nodeID),
+		zap.String("previousAvalancheGoPath", node.RuntimeConfig.AvalancheGoPath),
+		zap.String("avalancheGoPath", newBinaryPath),
+	)
+	node.RuntimeConfig.AvalancheGoPath = newBinaryPath
+	return n.RestartNode(ctx, log, node)
+}
+
+// RollingUpgrade restarts the nodes of the network with the avalanchego binary
+// at [newBinaryPath], [batchSize] nodes at a time. Each batch must report
+// healthy before the next batch is upgraded. To preserve quorum, [batchSize]
+// is capped so that a majority of nodes remain running while a batch is
+// restarted.
+func (n *Network) RollingUpgrade(ctx context.Context, log logging.Logger, newBinaryPath string, batchSize int) error {
+	return n.rollingUpgrade(log, batchSize, func(batch []*Node) error {
+		return n.upgradeBatch(ctx, log, newBinaryPath, batch)
+	})
+}
+
+func (n *Network) rollingUpgrade(
+	log logging.Logger,
+	batchSize int,
+	upgradeBatch func([]*Node) error,
+) error {
+	if batchSize <= 0 {
+		return errInvalidBatchSize
+	}
+	if maxBatchSize := maxRollingUpgradeBatchSize(len(n.Nodes)); batchSize > maxBatchSize {
+		log.Warn("reducing batch size to keep a majority of nodes running",
+			zap.Int("batchSize", batchSize),
+			zap.Int("maxBatchSize", maxBatchSize),
+		)
+		batchSize = maxBatchSize
+	}
+
+	for start := 0; start < len(n.Nodes); start += batchSize {
+		batch := n.Nodes[start:min(start+batchSize, len(n.Nodes))]
+		if err := upgradeBatch(batch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// maxRollingUpgradeBatchSize returns the largest number of nodes that can be
+// stopped at once while a majority of [nodeCount] nodes remain running. A
+// network of fewer than 3 nodes can't keep a majority running while any node
+// is stopped, so its nodes are upgraded one at a time.
+func maxRollingUpgradeBatchSize(nodeCount int) int {
+	return max(1, (nodeCount-1)/2)
+}
+
+// upgradeBatch restarts the provided nodes with the avalanchego binary at
+// [newBinaryPath] and waits for them to report healthy.
+func (n *Network) upgradeBatch(ctx context.Context, log logging.Logger, newBinaryPath string, batch []*Node) error {
+	log.Info("upgrading batch of nodes",
+		zap.Stringers("nodeIDs", NodesToIDs(batch...)),
+		zap.String("avalancheGoPath", newBinaryPath),
+	)
+
+	for _, node := range batch {
+		if err := n.EnsureNodeConfig(node); err != nil {
+			return err
+		}
+		if err := stopNodeForRestart(ctx, node); err != nil {
+			return err
+		}
+	}
+	for _, node := range batch {
+		node.RuntimeConfig.AvalancheGoPath = newBinaryPath
+		if err := n.StartNode(ctx, log, node); err != nil {
+			return fmt.Errorf("failed to start node %s: %w", node.NodeID, err)
+		}
+	}
+
+	log.Info("waiting for upgraded nodes to report healthy")
+	return waitForHealthy(ctx, log, batch)
+}
+
+// EnsureMinimumNodes starts new permanent nodes until the network has at least
+// [count] nodes. The new nodes are not validators.
+func (n *Network) EnsureMinimumNodes(ctx context.Context, log logging.Logger, count int) error {
+	return n.ensureMinimumNodes(log, count, func(nodes ...*Node) error {
+		return n.StartNodes(ctx, log, nodes...)
+	})
+}
+
+func (n *Network) ensureMinimumNodes(
+	log logging.Logger,
+	count int,
+	startNodes func(...*Node) error,
+) error {
+	missingNodes := count - len(n.Nodes)
+	if missingNodes <= 0 {
+		return nil
+	}
+
+	log.Info("adding nodes to reach the minimum node count",
+		zap.Int("minimumNodeCount", count),
+		zap.Int("newNodeCount", missingNodes),
+	)
+	newNodes := make([]*Node, missingNodes)
+	for i := range newNodes {
+		node := NewNode("")
+		if err := n.EnsureNodeConfig(node); err != nil {
+			return err
+		}
+		newNodes[i] = node
+	}
+	n.Nodes = append(n.Nodes, newNodes...)
+	return startNodes(newNodes...)
+}
+
 // Ensures the provided node has the configuration it needs to start. If the data dir is not
 // set, it will be defaulted to [nodeParentDir]/[node ID]. For a not-yet-created network,
 // no action will be taken.
@@ -600,7 +739,7 @@ func (n *Network) EnsureNodeConfig(node *Node) error {
 		dataDir := node.GetDataDir()
 		if len(dataDir) == 0 {
 			// NodeID will have been set by EnsureKeys
-			dataDir = filepath.Join(n.Dir, node.NodeID.String())
+			dataDir = n.GetNodeDir(node.NodeID)
 			node.Flags[config.DataDirKey] = dataDir
 		}
 	}
@@ -620,7 +759,7 @@ func (n *Network) EnsureNodeConfig(node *Node) error {
 func (n *Network) TrackedSubnetsForNode(nodeID ids.NodeID) string {
 	subnetIDs := make([]string, 0, len(n.Subnets))
 	for _, subnet := range n.Subnets {
-		if subnet.SubnetID == ids.Empty {
+		if subnet.SubnetID.IsZero() {
 			// Subnet has not yet been created
 			continue
 		}
@@ -647,13 +786,57 @@ func (n *Network) GetSubnet(name string) *Subnet {
 // Ensure that each subnet on the network is created. If restartRequired is false, node restart
 // to pick up configuration changes becomes the responsibility of the caller.
 func (n *Network) CreateSubnets(ctx context.Context, log logging.Logger, apiURI string, restartRequired bool) error {
-	createdSubnets := make([]*Subnet, 0, len(n.Subnets))
+	return n.createSubnets(ctx, log, newAPISubnetCreator(apiURI), restartRequired, false /* resume */)
+}
+
+// CreateSubnetsIfNeeded creates the network's subnets that have not already
+// been created. Unlike CreateSubnets, which relies on the subnet IDs known to
+// the in-memory network, the subnet configuration on disk is consulted first so
+// that subnets created by a previous, partially failed invocation are not
+// created again. The creation of such subnets is resumed instead: validators
+// that are missing are added and chains that are missing are created.
+func (n *Network) CreateSubnetsIfNeeded(ctx context.Context, log logging.Logger, apiURI string) error {
+	return n.createSubnetsIfNeeded(ctx, log, newAPISubnetCreator(apiURI))
+}
+
+func (n *Network) createSubnetsIfNeeded(ctx context.Context, log logging.Logger, creator subnetCreator) error {
+	if err := n.syncCreatedSubnets(); err != nil {
+		return err
+	}
+	return n.createSubnets(ctx, log, creator, true /* restartRequired */, true /* resume */)
+}
+
+// createSubnets creates each subnet on the network that hasn't been created. If
+// resume is true, the validators and chains of subnets that have already been
+// created are also ensured, so that subnets whose creation previously failed
+// part way through are completed.
+func (n *Network) createSubnets(
+	ctx context.Context,
+	log logging.Logger,
+	creator subnetCreator,
+	restartRequired bool,
+	resume bool,
+) error {
+	pendingSubnets := make([]*Subnet, 0, len(n.Subnets))
 	for _, subnet := range n.Subnets {
 		if len(subnet.ValidatorIDs) == 0 {
 			return fmt.Errorf("subnet %s needs at least one validator", subnet.SubnetID)
 		}
-		if subnet.SubnetID != ids.Empty {
+		if !subnet.SubnetID.IsZero() {
 			// The subnet already exists
+			if !resume {
+				continue
+			}
+
+			log.Info("resuming creation of subnet",
+				zap.String("name", subnet.Name),
+				zap.Stringer("id", subnet.SubnetID),
+			)
+
+			// The removal of the subnet's key from the pre-funded keys may
+			// not have been persisted.
+			n.removePreFundedKey(subnet.OwningKey)
+			pendingSubnets = append(pendingSubnets, subnet)
 			continue
 		}
 
@@ -672,7 +855,7 @@ func (n *Network) CreateSubnets(ctx context.Context, log logging.Logger, apiURI
 		}
 
 		// Create the subnet on the network
-		if err := subnet.Create(ctx, apiURI); err != nil {
+		if err := creator.createSubnet(ctx, subnet); err != nil {
 			return err
 		}
 
@@ -690,10 +873,10 @@ func (n *Network) CreateSubnets(ctx context.Context, log logging.Logger, apiURI
 			zap.String("name", subnet.Name),
 		)
 
-		createdSubnets = append(createdSubnets, subnet)
+		pendingSubnets = append(pendingSubnets, subnet)
 	}
 
-	if len(createdSubnets) == 0 {
+	if len(pendingSubnets) == 0 {
 		return nil
 	}
 
@@ -731,7 +914,7 @@ func (n *Network) CreateSubnets(ctx context.Context, log logging.Logger, apiURI
 	}
 
 	// Add validators for the subnet
-	for _, subnet := range createdSubnets {
+	for _, subnet := range pendingSubnets {
 		log.Info("adding validators for subnet",
 			zap.String("name", subnet.Name),
 		)
@@ -747,21 +930,23 @@ func (n *Network) CreateSubnets(ctx context.Context, log logging.Logger, apiURI
 			validatorNodes = append(validatorNodes, node)
 		}
 
-		if err := subnet.AddValidators(ctx, log, apiURI, validatorNodes...); err != nil {
+		if err := creator.addValidators(ctx, log, subnet, validatorNodes); err != nil {
 			return err
 		}
 	}
 
 	// Wait for nodes to become subnet validators
-	pChainClient := platformvm.NewClient(apiURI)
 	validatorsToRestart := set.Set[ids.NodeID]{}
-	for _, subnet := range createdSubnets {
-		if err := WaitForActiveValidators(ctx, log, pChainClient, subnet); err != nil {
+	for _, subnet := range pendingSubnets {
+		if err := creator.waitForActiveValidators(ctx, log, subnet); err != nil {
 			return err
 		}
 
+		// A resumed subnet may already have some or all of its chains
+		createsChains := subnet.hasUncreatedChains()
+
 		// It should now be safe to create chains for the subnet
-		if err := subnet.CreateChains(ctx, log, apiURI); err != nil {
+		if err := creator.createChains(ctx, log, subnet); err != nil {
 			return err
 		}
 
@@ -776,7 +961,7 @@ func (n *Network) CreateSubnets(ctx context.Context, log logging.Logger, apiURI
 		// If one or more of the subnets chains have explicit configuration, the
 		// subnet's validator nodes will need to be restarted for those nodes to read
 		// the newly written chain configuration and apply it to the chain(s).
-		if subnet.HasChainConfig() {
+		if createsChains && subnet.HasChainConfig() {
 			validatorsToRestart.Add(subnet.ValidatorIDs...)
 		}
 	}
@@ -800,6 +985,39 @@ func (n *Network) CreateSubnets(ctx context.Context, log logging.Logger, apiURI
 	return nil
 }
 
+// syncCreatedSubnets replaces each of the network's not-yet-created subnets
+// with the subnet of the same name written to disk, if that subnet has been
+// created.
+func (n *Network) syncCreatedSubnets() error {
+	writtenSubnets, err := readSubnets(n.GetSubnetDir())
+	if err != nil {
+		return err
+	}
+	for _, writtenSubnet := range writtenSubnets {
+		if writtenSubnet.SubnetID.IsZero() {
+			continue
+		}
+		for i, subnet := range n.Subnets {
+			if subnet.Name == writtenSubnet.Name && subnet.SubnetID.IsZero() {
+				n.Subnets[i] = writtenSubnet
+			}
+		}
+	}
+	return nil
+}
+
+// removePreFundedKey removes [key] from the network's pre-funded keys, if
+// present.
+func (n *Network) removePreFundedKey(key *secp256k1.PrivateKey) {
+	if key == nil {
+		return
+	}
+	address := key.Address()
+	n.PreFundedKeys = slices.DeleteFunc(n.PreFundedKeys, func(preFundedKey *secp256k1.PrivateKey) bool {
+		return preFundedKey.Address() == address
+	})
+}
+
 func (n *Network) GetNode(nodeID ids.NodeID) (*Node, error) {
 	for _, node := range n.Nodes {
 		if node.NodeID == nodeID {
@@ -809,6 +1027,22 @@ func (n *Network) GetNode(nodeID ids.NodeID) (*Node, error) {
 	return nil, fmt.Errorf("%s is not known to the network", nodeID)
 }
 
+// GetPreFundedKeyForNode returns the pre-funded key with the same index as the
+// specified node has in the network's nodes. False is returned if the node is
+// not part of the network or there is no key at its index.
+func (n *Network) GetPreFundedKeyForNode(nodeID ids.NodeID) (*secp256k1.PrivateKey, bool) {
+	for i, node := range n.Nodes {
+		if node.NodeID != nodeID {
+			continue
+		}
+		if i >= len(n.PreFundedKeys) {
+			return nil, false
+		}
+		return n.PreFundedKeys[i], true
+	}
+	return nil, false
+}
+
 func (n *Network) GetNodeURIs() []NodeURI {
 	return GetNodeURIs(n.Nodes)
 }
@@ -855,6 +1089,32 @@ func (n *Network) GetNetworkID() uint32 {
 	return n.NetworkID
 }
 
+// GetRunningDuration returns the time elapsed since the network was created,
+// as recorded in the name of the network dir.
+func (n *Network) GetRunningDuration() (time.Duration, error) {
+	createdAt, err := parseNetworkDirTime(n.Dir)
+	if err != nil {
+		return 0, err
+	}
+	return time.Since(createdAt), nil
+}
+
+// parseNetworkDirTime returns the creation time encoded in the name of the
+// provided network dir.
+func parseNetworkDirTime(dir string) (time.Time, error) {
+	// The creation time may be followed by a hyphen and the network owner
+	dirName := filepath.Base(dir)
+	parts := strings.SplitN(dirName, "-", 3)
+	if len(parts) < 2 {
+		return time.Time{}, fmt.Errorf("%w from dir %q", ErrCannotParseNetworkAge, dirName)
+	}
+	createdAt, err := time.ParseInLocation(networkDirTimeFormat, parts[0]+"-"+parts[1], time.Local)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w from dir %q: %w", ErrCannotParseNetworkAge, dirName, err)
+	}
+	return createdAt, nil
+}
+
 // For consumption outside of avalanchego. Needs to be kept exported.
 func (n *Network) GetPluginDir() (string, error) {
 	return n.DefaultFlags.GetStringVal(config.PluginDirKey)
@@ -881,7 +1141,7 @@ func (n *Network) GetSubnetConfigContent() (string, error) {
 
 	// Collect configuration for non-primary subnets
 	for _, subnet := range n.Subnets {
-		if subnet.SubnetID == ids.Empty {
+		if subnet.SubnetID.IsZero() {
 			// The subnet hasn't been created yet and it's not
 			// possible to supply configuration without an ID.
 			continue
@@ -920,7 +1180,7 @@ func (n *Network) GetChainConfigContent() (string, error) {
 	// Collect custom chain configuration
 	for _, subnet := range n.Subnets {
 		for _, chain := range subnet.Chains {
-			if chain.ChainID == ids.Empty {
+			if chain.ChainID.IsZero() {
 				// The chain hasn't been created yet and it's not possible to supply
 				// configuration without a chain ID.
 				continue
@@ -1048,6 +1308,15 @@ func getDefaultRootNetworkDir() (string, error) {
 	return filepath.Join(tmpnetPath, "networks"), nil
 }
 
+// GetRootDir returns the root dir for storing networks: the value of
+// TMPNET_ROOT_DIR if set, otherwise the default root dir.
+func GetRootDir() (string, error) {
+	if rootDir := os.Getenv(RootDirEnvName); len(rootDir) > 0 {
+		return rootDir, nil
+	}
+	return getDefaultRootNetworkDir()
+}
+
 // Retrieves the path to a reusable network path for the given owner.
 func GetReusableNetworkPathForOwner(owner string) (string, error) {
 	networkPath, err := getDefaultRootNetworkDir()
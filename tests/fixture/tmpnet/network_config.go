@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 
 	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/subnets"
 	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
 	"github.com/ava-labs/avalanchego/utils/perms"
@@ -19,7 +20,10 @@ import (
 // The Network type is defined in this file (reading/writing configuration) and network.go
 // (orchestration).
 
-var errMissingNetworkDir = errors.New("failed to write network: missing network directory")
+var (
+	errMissingNetworkDir = errors.New("failed to write network: missing network directory")
+	errSubnetMismatch    = errors.New("subnet configuration on disk does not match the network")
+)
 
 // Read network and node configuration from disk.
 func (n *Network) Read() error {
@@ -174,10 +178,70 @@ func (n *Network) writeEnvFile() error {
 	return nil
 }
 
+// GetSubnetDir returns the path of the dir storing the configuration of the
+// network's subnets. An empty string is returned if the network dir is not set.
 func (n *Network) GetSubnetDir() string {
+	if len(n.Dir) == 0 {
+		return ""
+	}
 	return filepath.Join(n.Dir, defaultSubnetDirName)
 }
 
+// GetNodeDir returns the path of the default data dir for the node with the
+// given ID. An empty string is returned if the network dir is not set.
+func (n *Network) GetNodeDir(nodeID ids.NodeID) string {
+	if len(n.Dir) == 0 {
+		return ""
+	}
+	return filepath.Join(n.Dir, nodeID.String())
+}
+
+// ListSubnetNames returns the names of the subnets whose configuration has
+// been written to the network's subnet dir.
+func (n *Network) ListSubnetNames() ([]string, error) {
+	subnets, err := readSubnets(n.GetSubnetDir())
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(subnets))
+	for i, subnet := range subnets {
+		names[i] = subnet.Name
+	}
+	return names, nil
+}
+
+// ReadSubnets reads the subnet configuration written to the network's subnet
+// dir without modifying the network. An error is returned if the subnets on
+// disk are inconsistent with the subnets of the network: a subnet created by
+// the network must have been written, and a subnet known to both must have the
+// same ID.
+func (n *Network) ReadSubnets() ([]*Subnet, error) {
+	subnets, err := readSubnets(n.GetSubnetDir())
+	if err != nil {
+		return nil, err
+	}
+
+	writtenSubnets := make(map[string]*Subnet, len(subnets))
+	for _, subnet := range subnets {
+		writtenSubnets[subnet.Name] = subnet
+	}
+	for _, subnet := range n.Subnets {
+		writtenSubnet, ok := writtenSubnets[subnet.Name]
+		switch {
+		case !ok && !subnet.SubnetID.IsZero():
+			return nil, fmt.Errorf("%w: subnet %q was created but not written", errSubnetMismatch, subnet.Name)
+		case ok && writtenSubnet.SubnetID != subnet.SubnetID:
+			return nil, fmt.Errorf("%w: subnet %q has ID %s on disk but %s in the network",
+				errSubnetMismatch,
+				subnet.Name,
+				writtenSubnet.SubnetID,
+				subnet.SubnetID,
+			)
+		}
+	}
+	return subnets, nil
+}
+
 func (n *Network) readSubnets() error {
 	subnets, err := readSubnets(n.GetSubnetDir())
 	if err != nil {
@@ -4,7 +4,12 @@
 package tmpnet
 
 import (
+	"context"
+	"errors"
+	"path/filepath"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -39,3 +44,445 @@ func TestNetworkSerialization(t *testing.T) {
 	}
 	require.Equal(network, loadedNetwork)
 }
+
+func TestGetPreFundedKeyForNode(t *testing.T) {
+	require := require.New(t)
+
+	keys, err := NewPrivateKeys(2)
+	require.NoError(err)
+	network := &Network{
+		Nodes:         NewNodesOrPanic(3),
+		PreFundedKeys: keys,
+	}
+
+	for i, node := range network.Nodes[:2] {
+		key, ok := network.GetPreFundedKeyForNode(node.NodeID)
+		require.True(ok)
+		require.Equal(keys[i], key)
+
+		// The mapping should be stable across calls
+		key, ok = network.GetPreFundedKeyForNode(node.NodeID)
+		require.True(ok)
+		require.Equal(keys[i], key)
+	}
+
+	// No key is available for a node whose index exceeds the number of keys
+	_, ok := network.GetPreFundedKeyForNode(network.Nodes[2].NodeID)
+	require.False(ok)
+
+	// No key is available for a node that isn't part of the network
+	_, ok = network.GetPreFundedKeyForNode(ids.GenerateTestNodeID())
+	require.False(ok)
+}
+
+func TestParseNetworkDirTime(t *testing.T) {
+	createdAt := time.Date(2024, time.March, 4, 15, 30, 45, 123456000, time.Local)
+	tests := []struct {
+		name         string
+		dir          string
+		expectedTime time.Time
+		expectedErr  error
+	}{
+		{
+			name:         "without owner",
+			dir:          filepath.Join("networks", "20240304-153045.123456"),
+			expectedTime: createdAt,
+		},
+		{
+			name:         "with owner",
+			dir:          filepath.Join("networks", "20240304-153045.123456-avalanchego-e2e"),
+			expectedTime: createdAt,
+		},
+		{
+			name:         "without fractional seconds",
+			dir:          "20240304-153045",
+			expectedTime: createdAt.Truncate(time.Second),
+		},
+		{
+			name:        "not a timestamp",
+			dir:         "latest_avalanchego-e2e",
+			expectedErr: ErrCannotParseNetworkAge,
+		},
+		{
+			name:        "empty",
+			dir:         "",
+			expectedErr: ErrCannotParseNetworkAge,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			parsedTime, err := parseNetworkDirTime(test.dir)
+			require.ErrorIs(err, test.expectedErr)
+			require.True(test.expectedTime.Equal(parsedTime))
+		})
+	}
+}
+
+func TestGetRunningDuration(t *testing.T) {
+	require := require.New(t)
+
+	network := NewDefaultNetwork("testnet")
+	require.NoError(network.EnsureDefaultConfig(logging.NoLog{}, "/path/to/avalanche/go", ""))
+	require.NoError(network.Create(t.TempDir()))
+
+	duration, err := network.GetRunningDuration()
+	require.NoError(err)
+	require.GreaterOrEqual(duration, time.Duration(0))
+	require.Less(duration, time.Minute)
+}
+
+func TestSyncCreatedSubnets(t *testing.T) {
+	require := require.New(t)
+
+	network := &Network{
+		Dir: t.TempDir(),
+	}
+
+	// Simulate a previous invocation of CreateSubnets that created and wrote
+	// one subnet before failing.
+	createdSubnet := &Subnet{
+		Name:     "created",
+		SubnetID: ids.GenerateTestID(),
+	}
+	require.NoError(createdSubnet.Write(network.GetSubnetDir()))
+
+	network.Subnets = []*Subnet{
+		{Name: "created"},
+		{Name: "missing"},
+	}
+	require.NoError(network.syncCreatedSubnets())
+	require.Equal(createdSubnet.SubnetID, network.Subnets[0].SubnetID)
+	require.True(network.Subnets[1].SubnetID.IsZero())
+}
+
+// testSubnetCreator simulates the creation of subnets on a running network.
+type testSubnetCreator struct {
+	// The creation of the subnet with this name fails
+	failingSubnetName string
+
+	createdSubnets   []string
+	validatedSubnets []string
+	chainSubnets     []string
+}
+
+func (c *testSubnetCreator) createSubnet(_ context.Context, subnet *Subnet) error {
+	if subnet.Name == c.failingSubnetName {
+		return errTestSubnetCreation
+	}
+	subnet.SubnetID = ids.GenerateTestID()
+	c.createdSubnets = append(c.createdSubnets, subnet.Name)
+	return nil
+}
+
+func (c *testSubnetCreator) addValidators(_ context.Context, _ logging.Logger, subnet *Subnet, _ []*Node) error {
+	c.validatedSubnets = append(c.validatedSubnets, subnet.Name)
+	return nil
+}
+
+func (*testSubnetCreator) waitForActiveValidators(context.Context, logging.Logger, *Subnet) error {
+	return nil
+}
+
+func (c *testSubnetCreator) createChains(_ context.Context, _ logging.Logger, subnet *Subnet) error {
+	for _, chain := range subnet.Chains {
+		if chain.ChainID.IsZero() {
+			chain.ChainID = ids.GenerateTestID()
+		}
+	}
+	c.chainSubnets = append(c.chainSubnets, subnet.Name)
+	return nil
+}
+
+var errTestSubnetCreation = errors.New("subnet creation failed")
+
+func TestCreateSubnetsIfNeededResumesPartialFailure(t *testing.T) {
+	require := require.New(t)
+
+	network := NewDefaultNetwork("testnet")
+	require.NoError(network.EnsureDefaultConfig(logging.NoLog{}, "/path/to/avalanche/go", ""))
+	require.NoError(network.Create(t.TempDir()))
+	preFundedKeyCount := len(network.PreFundedKeys)
+
+	newSubnets := func() []*Subnet {
+		return []*Subnet{
+			{
+				Name:         "created",
+				ValidatorIDs: []ids.NodeID{network.Nodes[0].NodeID},
+				Chains:       []*Chain{{VMID: ids.GenerateTestID()}},
+			},
+			{
+				Name:         "failed",
+				ValidatorIDs: []ids.NodeID{network.Nodes[0].NodeID},
+				Chains:       []*Chain{{VMID: ids.GenerateTestID()}},
+			},
+		}
+	}
+
+	// Simulate an invocation that creates and writes the first subnet before
+	// failing to create the second one. None of the steps that follow
+	// creation are performed for the first subnet.
+	network.Subnets = newSubnets()
+	failingCreator := &testSubnetCreator{failingSubnetName: "failed"}
+	err := network.createSubnets(context.Background(), logging.NoLog{}, failingCreator, false /* restartRequired */, false /* resume */)
+	require.ErrorIs(err, errTestSubnetCreation)
+	require.Equal([]string{"created"}, failingCreator.createdSubnets)
+	require.Empty(failingCreator.validatedSubnets)
+	require.Empty(failingCreator.chainSubnets)
+	createdSubnetID := network.Subnets[0].SubnetID
+	owningKey := network.Subnets[0].OwningKey
+
+	// Simulate a new invocation for the same network and subnets. The removal
+	// of the first subnet's key from the pre-funded keys was never persisted.
+	resumedNetwork, err := ReadNetwork(network.Dir)
+	require.NoError(err)
+	require.Len(resumedNetwork.PreFundedKeys, preFundedKeyCount)
+	resumedNetwork.Subnets = newSubnets()
+
+	creator := &testSubnetCreator{}
+	require.NoError(resumedNetwork.createSubnetsIfNeeded(context.Background(), logging.NoLog{}, creator))
+
+	// Only the missing subnet is created, but the remaining steps are
+	// performed for both subnets.
+	require.Equal([]string{"failed"}, creator.createdSubnets)
+	require.Equal([]string{"created", "failed"}, creator.validatedSubnets)
+	require.Equal([]string{"created", "failed"}, creator.chainSubnets)
+	require.Equal(createdSubnetID, resumedNetwork.Subnets[0].SubnetID)
+
+	// The subnet configuration and the pre-funded key removal are persisted
+	writtenNetwork, err := ReadNetwork(network.Dir)
+	require.NoError(err)
+	require.Len(writtenNetwork.PreFundedKeys, preFundedKeyCount-2)
+	for _, key := range writtenNetwork.PreFundedKeys {
+		require.NotEqual(owningKey.Address(), key.Address())
+	}
+	require.Len(writtenNetwork.Subnets, 2)
+	for _, subnet := range writtenNetwork.Subnets {
+		require.False(subnet.SubnetID.IsZero())
+		require.False(subnet.hasUncreatedChains())
+	}
+}
+
+func TestGetSubnetDir(t *testing.T) {
+	require := require.New(t)
+
+	require.Empty((&Network{}).GetSubnetDir())
+
+	network := NewDefaultNetwork("testnet")
+	require.NoError(network.EnsureDefaultConfig(logging.NoLog{}, "/path/to/avalanche/go", ""))
+	require.NoError(network.Create(t.TempDir()))
+	subnetDir := network.GetSubnetDir()
+	require.Equal(network.Dir, filepath.Dir(subnetDir))
+	require.NoDirExists(subnetDir)
+
+	// The subnet dir is created when a new subnet is persisted
+	network.Subnets = []*Subnet{
+		{
+			Name:         "test",
+			ValidatorIDs: []ids.NodeID{network.Nodes[0].NodeID},
+		},
+	}
+	require.NoError(network.createSubnets(context.Background(), logging.NoLog{}, &testSubnetCreator{}, false /* restartRequired */, false /* resume */))
+	require.DirExists(subnetDir)
+	require.FileExists(filepath.Join(subnetDir, "test.json"))
+}
+
+func TestGetNodeDir(t *testing.T) {
+	require := require.New(t)
+
+	nodeID := ids.GenerateTestNodeID()
+	require.Empty((&Network{}).GetNodeDir(nodeID))
+
+	network := &Network{
+		Dir: t.TempDir(),
+	}
+	require.Equal(filepath.Join(network.Dir, nodeID.String()), network.GetNodeDir(nodeID))
+
+	node := NewNode("")
+	require.NoError(network.EnsureNodeConfig(node))
+	require.Equal(network.GetNodeDir(node.NodeID), node.GetDataDir())
+}
+
+func TestRollingUpgrade(t *testing.T) {
+	tests := []struct {
+		name            string
+		nodeCount       int
+		batchSize       int
+		expectedBatches []int
+		expectedErr     error
+	}{
+		{
+			name:        "invalid batch size",
+			nodeCount:   5,
+			batchSize:   0,
+			expectedErr: errInvalidBatchSize,
+		},
+		{
+			name:            "single node",
+			nodeCount:       1,
+			batchSize:       1,
+			expectedBatches: []int{1},
+		},
+		{
+			name:            "two nodes upgraded one at a time",
+			nodeCount:       2,
+			batchSize:       2,
+			expectedBatches: []int{1, 1},
+		},
+		{
+			name:            "batch size within quorum",
+			nodeCount:       5,
+			batchSize:       2,
+			expectedBatches: []int{2, 2, 1},
+		},
+		{
+			name:            "batch size capped to preserve quorum",
+			nodeCount:       5,
+			batchSize:       3,
+			expectedBatches: []int{2, 2, 1},
+		},
+		{
+			name:            "batch size covering all nodes capped to preserve quorum",
+			nodeCount:       4,
+			batchSize:       4,
+			expectedBatches: []int{1, 1, 1, 1},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			network := &Network{
+				Nodes: NewNodesOrPanic(test.nodeCount),
+			}
+			var (
+				batchSizes    []int
+				upgradedNodes []*Node
+			)
+			upgradeBatch := func(batch []*Node) error {
+				if test.nodeCount >= 3 {
+					// A majority of nodes must remain running to preserve
+					// the health of the network.
+					runningNodes := test.nodeCount - len(batch)
+					require.Greater(runningNodes, test.nodeCount/2)
+				}
+				batchSizes = append(batchSizes, len(batch))
+				upgradedNodes = append(upgradedNodes, batch...)
+				return nil
+			}
+
+			err := network.rollingUpgrade(logging.NoLog{}, test.batchSize, upgradeBatch)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expectedBatches, batchSizes)
+			if test.expectedErr == nil {
+				require.Equal(network.Nodes, upgradedNodes)
+			}
+		})
+	}
+}
+
+func TestEnsureMinimumNodes(t *testing.T) {
+	require := require.New(t)
+
+	network := NewDefaultNetwork("testnet")
+	require.NoError(network.EnsureDefaultConfig(logging.NoLog{}, "/path/to/avalanche/go", ""))
+	require.NoError(network.Create(t.TempDir()))
+	var (
+		initialNodes = slices.Clone(network.Nodes)
+		nodeCount    = len(initialNodes)
+		startedNodes []*Node
+	)
+	startNodes := func(nodes ...*Node) error {
+		startedNodes = append(startedNodes, nodes...)
+		return nil
+	}
+
+	// No nodes are added to a network that already has enough nodes
+	require.NoError(network.ensureMinimumNodes(logging.NoLog{}, nodeCount, startNodes))
+	require.Equal(initialNodes, network.Nodes)
+	require.Empty(startedNodes)
+
+	require.NoError(network.ensureMinimumNodes(logging.NoLog{}, nodeCount+2, startNodes))
+	require.Len(network.Nodes, nodeCount+2)
+	require.Equal(initialNodes, network.Nodes[:nodeCount])
+	newNodes := network.Nodes[nodeCount:]
+	require.Equal(newNodes, startedNodes)
+	for _, node := range newNodes {
+		require.False(node.IsEphemeral)
+		require.Equal(network.GetNodeDir(node.NodeID), node.GetDataDir())
+	}
+}
+
+func TestReadSubnets(t *testing.T) {
+	var (
+		writtenSubnetID = ids.GenerateTestID()
+		otherSubnetID   = ids.GenerateTestID()
+	)
+	tests := []struct {
+		name        string
+		subnets     []*Subnet
+		expectedErr error
+	}{
+		{
+			name: "consistent",
+			subnets: []*Subnet{
+				{Name: "written", SubnetID: writtenSubnetID},
+			},
+		},
+		{
+			name: "written subnet not in network",
+		},
+		{
+			name: "uncreated subnet not written",
+			subnets: []*Subnet{
+				{Name: "written", SubnetID: writtenSubnetID},
+				{Name: "uncreated"},
+			},
+		},
+		{
+			name: "created subnet not written",
+			subnets: []*Subnet{
+				{Name: "written", SubnetID: writtenSubnetID},
+				{Name: "unwritten", SubnetID: otherSubnetID},
+			},
+			expectedErr: errSubnetMismatch,
+		},
+		{
+			name: "subnet IDs differ",
+			subnets: []*Subnet{
+				{Name: "written", SubnetID: otherSubnetID},
+			},
+			expectedErr: errSubnetMismatch,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			network := &Network{
+				Dir: t.TempDir(),
+			}
+			writtenSubnet := &Subnet{
+				Name:     "written",
+				SubnetID: writtenSubnetID,
+			}
+			require.NoError(writtenSubnet.Write(network.GetSubnetDir()))
+
+			names, err := network.ListSubnetNames()
+			require.NoError(err)
+			require.Equal([]string{"written"}, names)
+
+			network.Subnets = test.subnets
+			subnets, err := network.ReadSubnets()
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				return
+			}
+			require.Len(subnets, 1)
+			require.Equal(writtenSubnetID, subnets[0].SubnetID)
+			// Reading must not modify the network
+			require.Equal(test.subnets, network.Subnets)
+		})
+	}
+}
@@ -0,0 +1,494 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package tmpnet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/chains"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/tests"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+)
+
+const (
+	// Name of the gauge reporting the number of txs in the P-Chain mempool
+	pChainMempoolCountMetric = "avalanche_platformvm_mempool_count"
+	// Name of the counter, labeled by tx type, of accepted P-Chain txs
+	pChainTxsAcceptedMetric = "avalanche_platformvm_txs_accepted"
+)
+
+var (
+	ErrNoMetricsAvailable = errors.New("no metrics available")
+	ErrValidatorNotFound  = errors.New("validator not found")
+
+	errUptimeNotReported = errors.New("uptime not reported")
+	errHeightsDiverged   = errors.New("P-Chain heights diverged")
+)
+
+var pChainMetricLabels = prometheus.Labels{
+	chains.ChainLabel: "P",
+}
+
+// runningNodes returns the nodes of the network that have an API URI.
+func (n *Network) runningNodes() []*Node {
+	nodes := make([]*Node, 0, len(n.Nodes))
+	for _, node := range n.Nodes {
+		if len(node.URI) > 0 {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
+
+// FindSlowestNode returns the running node whose P-Chain timestamp lags
+// furthest behind the current time, along with the size of that lag. Since
+// P-Chain time only advances when blocks are accepted, the lag is only
+// meaningful for comparing nodes of a network with ongoing activity.
+func (n *Network) FindSlowestNode(ctx context.Context) (*Node, time.Duration, error) {
+	return n.findSlowestNode(ctx, time.Now(), getPChainTimestamp)
+}
+
+func (n *Network) findSlowestNode(
+	ctx context.Context,
+	now time.Time,
+	getTimestamp func(context.Context, *Node) (time.Time, error),
+) (*Node, time.Duration, error) {
+	var (
+		slowestNode *Node
+		maxLag      time.Duration
+	)
+	for _, node := range n.runningNodes() {
+		timestamp, err := getTimestamp(ctx, node)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to get P-Chain timestamp of node %s: %w", node.NodeID, err)
+		}
+		lag := now.Sub(timestamp)
+		if slowestNode == nil || lag > maxLag {
+			slowestNode = node
+			maxLag = lag
+		}
+	}
+	if slowestNode == nil {
+		return nil, 0, errNoRunningNodes
+	}
+	return slowestNode, maxLag, nil
+}
+
+// getPChainTimestamp returns the timestamp of the preferred P-Chain state of
+// the provided node.
+func getPChainTimestamp(ctx context.Context, node *Node) (time.Time, error) {
+	uri, cancel, err := node.GetLocalURI(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer cancel()
+	return platformvm.NewClient(uri).GetTimestamp(ctx)
+}
+
+// WaitForQuiescence waits until the P-Chain mempool of every running node
+// contains fewer than [maxPendingTxs] transactions. Mempool sizes are read from
+// the metrics reported by each node.
+func (n *Network) WaitForQuiescence(ctx context.Context, log logging.Logger, maxPendingTxs int) error {
+	ticker := time.NewTicker(DefaultPollingInterval)
+	defer ticker.Stop()
+
+	for {
+		quiescent, err := n.isQuiescent(ctx, log, maxPendingTxs)
+		if err != nil {
+			return err
+		}
+		if quiescent {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("failed to see P-Chain mempools drain before timeout: %w", ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+func (n *Network) isQuiescent(ctx context.Context, log logging.Logger, maxPendingTxs int) (bool, error) {
+	for _, node := range n.runningNodes() {
+		pendingTxs, err := getPChainMempoolCount(ctx, node)
+		if err != nil {
+			return false, err
+		}
+		if pendingTxs >= maxPendingTxs {
+			log.Debug("waiting for P-Chain mempool to drain",
+				zap.Stringer("nodeID", node.NodeID),
+				zap.Int("pendingTxs", pendingTxs),
+			)
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// getPChainMempoolCount returns the number of txs in the P-Chain mempool of
+// the provided node.
+func getPChainMempoolCount(ctx context.Context, node *Node) (int, error) {
+	uri, cancel, err := node.GetLocalURI(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer cancel()
+
+	metrics, err := tests.GetNodeMetrics(ctx, uri)
+	if err != nil {
+		return 0, fmt.Errorf("failed to retrieve metrics for node %s: %w", node.NodeID, err)
+	}
+	count, ok := tests.GetMetricValue(metrics, pChainMempoolCountMetric, pChainMetricLabels)
+	if !ok {
+		return 0, fmt.Errorf("metric %s not reported by node %s", pChainMempoolCountMetric, node.NodeID)
+	}
+	return int(count), nil
+}
+
+// GetAcceptedBlockRate returns the number of P-Chain blocks accepted per second
+// over [window], starting from when it is called. The rate is measured on each
+// running node and the median is returned to limit the impact of lagging nodes.
+func (n *Network) GetAcceptedBlockRate(ctx context.Context, window time.Duration) (float64, error) {
+	nodes := n.runningNodes()
+	if len(nodes) == 0 {
+		return 0, errNoRunningNodes
+	}
+
+	startHeights, err := getPChainHeights(ctx, nodes)
+	if err != nil {
+		return 0, err
+	}
+
+	timer := time.NewTimer(window)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return 0, fmt.Errorf("failed to measure accepted block rate before timeout: %w", ctx.Err())
+	case <-timer.C:
+	}
+
+	endHeights, err := getPChainHeights(ctx, nodes)
+	if err != nil {
+		return 0, err
+	}
+
+	rates := make([]float64, len(nodes))
+	for i := range nodes {
+		rates[i] = float64(endHeights[i]-startHeights[i]) / window.Seconds()
+	}
+	return median(rates), nil
+}
+
+// getPChainHeights returns the height of the last accepted P-Chain block of
+// each of the provided nodes.
+func getPChainHeights(ctx context.Context, nodes []*Node) ([]uint64, error) {
+	heights := make([]uint64, len(nodes))
+	for i, node := range nodes {
+		height, err := getPChainHeight(ctx, node)
+		if err != nil {
+			return nil, err
+		}
+		heights[i] = height
+	}
+	return heights, nil
+}
+
+func getPChainHeight(ctx context.Context, node *Node) (uint64, error) {
+	uri, cancel, err := node.GetLocalURI(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer cancel()
+
+	height, err := platformvm.NewClient(uri).GetHeight(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get P-Chain height of node %s: %w", node.NodeID, err)
+	}
+	return height, nil
+}
+
+// median returns the median of the provided values, which must not be empty.
+func median(values []float64) float64 {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[mid]
+	}
+	return (sorted[mid-1] + sorted[mid]) / 2
+}
+
+// GetTxAcceptanceRate returns the number of P-Chain txs accepted per second
+// over [window], starting from when it is called. The rate is computed from
+// the metrics of each running node and averaged across nodes.
+// ErrNoMetricsAvailable is returned if a node's metrics can't be retrieved.
+func (n *Network) GetTxAcceptanceRate(ctx context.Context, window time.Duration) (float64, error) {
+	nodes := n.runningNodes()
+	if len(nodes) == 0 {
+		return 0, errNoRunningNodes
+	}
+
+	startCounts, err := getPChainTxsAccepted(ctx, nodes)
+	if err != nil {
+		return 0, err
+	}
+
+	timer := time.NewTimer(window)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return 0, fmt.Errorf("failed to measure tx acceptance rate before timeout: %w", ctx.Err())
+	case <-timer.C:
+	}
+
+	endCounts, err := getPChainTxsAccepted(ctx, nodes)
+	if err != nil {
+		return 0, err
+	}
+
+	var totalRate float64
+	for i := range nodes {
+		totalRate += (endCounts[i] - startCounts[i]) / window.Seconds()
+	}
+	return totalRate / float64(len(nodes)), nil
+}
+
+// getPChainTxsAccepted returns the number of P-Chain txs, of all types,
+// accepted by each of the provided nodes.
+func getPChainTxsAccepted(ctx context.Context, nodes []*Node) ([]float64, error) {
+	counts := make([]float64, len(nodes))
+	for i, node := range nodes {
+		count, err := getPChainTxsAcceptedForNode(ctx, node)
+		if err != nil {
+			return nil, err
+		}
+		counts[i] = count
+	}
+	return counts, nil
+}
+
+func getPChainTxsAcceptedForNode(ctx context.Context, node *Node) (float64, error) {
+	uri, cancel, err := node.GetLocalURI(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer cancel()
+
+	metrics, err := tests.GetNodeMetrics(ctx, uri)
+	if err != nil {
+		return 0, fmt.Errorf("%w for node %s: %w", ErrNoMetricsAvailable, node.NodeID, err)
+	}
+	return sumCounters(metrics, pChainTxsAcceptedMetric, pChainMetricLabels), nil
+}
+
+// sumCounters returns the sum of the counters of the named metric family with
+// the provided labels. A missing metric family, as is the case for a counter
+// vector that has yet to be incremented, is treated as zero.
+func sumCounters(metrics tests.NodeMetrics, name string, labels prometheus.Labels) float64 {
+	metricFamily, ok := metrics[name]
+	if !ok {
+		return 0
+	}
+
+	var sum float64
+	for _, metric := range metricFamily.Metric {
+		if metric.Counter == nil {
+			continue
+		}
+		matched := 0
+		for _, label := range metric.Label {
+			if value, ok := labels[label.GetName()]; ok && value == label.GetValue() {
+				matched++
+			}
+		}
+		if matched == len(labels) {
+			sum += metric.Counter.GetValue()
+		}
+	}
+	return sum
+}
+
+// GetUptime returns the uptime, as a fraction between 0 and 1, that the
+// network observes for the primary network validator [nodeID].
+// ErrValidatorNotFound is returned if [nodeID] is not a current validator.
+func (n *Network) GetUptime(ctx context.Context, nodeID ids.NodeID) (float64, error) {
+	return n.getUptime(ctx, nodeID, getPrimaryNetworkValidators)
+}
+
+func (n *Network) getUptime(
+	ctx context.Context,
+	nodeID ids.NodeID,
+	getValidators func(context.Context, *Node, []ids.NodeID) ([]platformvm.ClientPermissionlessValidator, error),
+) (float64, error) {
+	nodes := n.runningNodes()
+	if len(nodes) == 0 {
+		return 0, errNoRunningNodes
+	}
+
+	validators, err := getValidators(ctx, nodes[0], []ids.NodeID{nodeID})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get current validators: %w", err)
+	}
+	for _, validator := range validators {
+		if validator.NodeID != nodeID {
+			continue
+		}
+		if validator.Uptime == nil {
+			return 0, fmt.Errorf("%w for validator %s", errUptimeNotReported, nodeID)
+		}
+		// The API reports uptime as a percentage
+		return float64(*validator.Uptime) / 100, nil
+	}
+	return 0, fmt.Errorf("%w: %s", ErrValidatorNotFound, nodeID)
+}
+
+// getPrimaryNetworkValidators returns the current primary network validators
+// with the provided IDs as reported by [node].
+func getPrimaryNetworkValidators(
+	ctx context.Context,
+	node *Node,
+	nodeIDs []ids.NodeID,
+) ([]platformvm.ClientPermissionlessValidator, error) {
+	uri, cancel, err := node.GetLocalURI(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+	return platformvm.NewClient(uri).GetCurrentValidators(ctx, constants.PrimaryNetworkID, nodeIDs)
+}
+
+// AssertUptimeAbove waits until the uptime reported by GetUptime for
+// [nodeID] exceeds [minUptime]. An error is returned if this is not observed
+// before [ctx] expires.
+func (n *Network) AssertUptimeAbove(ctx context.Context, nodeID ids.NodeID, minUptime float64) error {
+	return assertUptimeAbove(ctx, nodeID, minUptime, n.GetUptime)
+}
+
+func assertUptimeAbove(
+	ctx context.Context,
+	nodeID ids.NodeID,
+	minUptime float64,
+	getUptime func(context.Context, ids.NodeID) (float64, error),
+) error {
+	ticker := time.NewTicker(DefaultPollingInterval)
+	defer ticker.Stop()
+
+	var (
+		uptime float64
+		err    error
+	)
+	for {
+		uptime, err = getUptime(ctx, nodeID)
+		if err == nil && uptime > minUptime {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			if err != nil {
+				return fmt.Errorf("failed to see uptime of %s above %f before timeout: %w", nodeID, minUptime, err)
+			}
+			return fmt.Errorf("failed to see uptime of %s above %f before timeout (last observed %f): %w", nodeID, minUptime, uptime, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// AssertAllChainsAtSameHeight checks that the last accepted P-Chain height of
+// every running node is within one block of the height reported by the
+// majority of nodes.
+func (n *Network) AssertAllChainsAtSameHeight(ctx context.Context) error {
+	nodes := n.runningNodes()
+	if len(nodes) == 0 {
+		return errNoRunningNodes
+	}
+	heights, err := getPChainHeights(ctx, nodes)
+	if err != nil {
+		return err
+	}
+	return checkHeightsConverged(NodesToIDs(nodes...), heights)
+}
+
+// checkHeightsConverged returns an error if any of the provided heights
+// differs by more than one from the most common height.
+func checkHeightsConverged(nodeIDs []ids.NodeID, heights []uint64) error {
+	counts := make(map[uint64]int, len(heights))
+	for _, height := range heights {
+		counts[height]++
+	}
+	var majorityHeight uint64
+	for height, count := range counts {
+		majorityCount := counts[majorityHeight]
+		if count > majorityCount || (count == majorityCount && height > majorityHeight) {
+			majorityHeight = height
+		}
+	}
+
+	var errs []error
+	for i, height := range heights {
+		if max(height, majorityHeight)-min(height, majorityHeight) > 1 {
+			errs = append(errs, fmt.Errorf("node %s is at height %d", nodeIDs[i], height))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%w from majority height %d:\n%w", errHeightsDiverged, majorityHeight, errors.Join(errs...))
+	}
+	return nil
+}
+
+// GetChainHeights returns the last accepted P-Chain height of each running
+// node, queried in parallel. Nodes that fail to report a height are logged and
+// included with a height of zero.
+func (n *Network) GetChainHeights(ctx context.Context, log logging.Logger) (map[ids.NodeID]uint64, error) {
+	return n.getChainHeights(ctx, log, getPChainHeight)
+}
+
+func (n *Network) getChainHeights(
+	ctx context.Context,
+	log logging.Logger,
+	getHeight func(context.Context, *Node) (uint64, error),
+) (map[ids.NodeID]uint64, error) {
+	nodes := n.runningNodes()
+	if len(nodes) == 0 {
+		return nil, errNoRunningNodes
+	}
+
+	var (
+		heights = make(map[ids.NodeID]uint64, len(nodes))
+		lock    sync.Mutex
+		wg      sync.WaitGroup
+	)
+	for _, node := range nodes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			height, err := getHeight(ctx, node)
+			if err != nil {
+				log.Warn("failed to get P-Chain height",
+					zap.Stringer("nodeID", node.NodeID),
+					zap.Error(err),
+				)
+			}
+
+			lock.Lock()
+			defer lock.Unlock()
+			heights[node.NodeID] = height
+		}()
+	}
+	wg.Wait()
+	return heights, nil
+}
@@ -0,0 +1,256 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package tmpnet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/tests"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+
+	dto "github.com/prometheus/client_model/go"
+)
+
+// newTestRunningNodes returns nodes that appear to be running to
+// Network.runningNodes.
+func newTestRunningNodes(t *testing.T, count int) []*Node {
+	nodes := NewNodesOrPanic(count)
+	for i, node := range nodes {
+		require.NoError(t, node.EnsureKeys())
+		node.URI = fmt.Sprintf("http://127.0.0.1:%d", 9650+i)
+	}
+	return nodes
+}
+
+func TestFindSlowestNode(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		now      = time.Now()
+		nodes    = newTestRunningNodes(t, 3)
+		stopped  = NewNodesOrPanic(1)[0]
+		network  = &Network{Nodes: append(nodes, stopped)}
+		expected = nodes[1]
+	)
+	timestamps := map[*Node]time.Time{
+		nodes[0]: now.Add(-time.Second),
+		nodes[1]: now.Add(-time.Minute),
+		nodes[2]: now,
+	}
+	getTimestamp := func(_ context.Context, node *Node) (time.Time, error) {
+		require.NotEqual(stopped, node)
+		return timestamps[node], nil
+	}
+
+	node, lag, err := network.findSlowestNode(context.Background(), now, getTimestamp)
+	require.NoError(err)
+	require.Equal(expected, node)
+	require.Equal(time.Minute, lag)
+
+	_, _, err = (&Network{Nodes: []*Node{stopped}}).findSlowestNode(context.Background(), now, getTimestamp)
+	require.ErrorIs(err, errNoRunningNodes)
+}
+
+func TestMedian(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []float64
+		expected float64
+	}{
+		{
+			name:     "single value",
+			values:   []float64{1},
+			expected: 1,
+		},
+		{
+			name:     "odd number of values",
+			values:   []float64{3, 1, 2},
+			expected: 2,
+		},
+		{
+			name:     "even number of values",
+			values:   []float64{4, 1, 3, 2},
+			expected: 2.5,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, median(test.values))
+		})
+	}
+}
+
+func TestSumCounters(t *testing.T) {
+	require := require.New(t)
+
+	counter := func(value float64, labels ...string) *dto.Metric {
+		metric := &dto.Metric{
+			Counter: &dto.Counter{Value: &value},
+		}
+		for i := 0; i < len(labels); i += 2 {
+			metric.Label = append(metric.Label, &dto.LabelPair{
+				Name:  &labels[i],
+				Value: &labels[i+1],
+			})
+		}
+		return metric
+	}
+	metrics := tests.NodeMetrics{
+		pChainTxsAcceptedMetric: {
+			Metric: []*dto.Metric{
+				counter(1, "chain", "P", "tx", "base"),
+				counter(2, "chain", "P", "tx", "import"),
+				counter(4, "chain", "X", "tx", "base"),
+			},
+		},
+	}
+	require.Equal(float64(3), sumCounters(metrics, pChainTxsAcceptedMetric, pChainMetricLabels))
+	require.Zero(sumCounters(metrics, "unknown", pChainMetricLabels))
+}
+
+func TestAssertUptimeAbove(t *testing.T) {
+	const minUptime = .99
+
+	var (
+		nodes     = newTestRunningNodes(t, 2)
+		network   = &Network{Nodes: nodes}
+		validator = nodes[1].NodeID
+	)
+	newValidators := func(uptime float32) []platformvm.ClientPermissionlessValidator {
+		return []platformvm.ClientPermissionlessValidator{
+			{
+				ClientStaker: platformvm.ClientStaker{
+					NodeID: validator,
+				},
+				Uptime: &uptime,
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		validators  []platformvm.ClientPermissionlessValidator
+		expectedErr error
+	}{
+		{
+			name:       "uptime above threshold",
+			validators: newValidators(99.5),
+		},
+		{
+			name:        "uptime below threshold",
+			validators:  newValidators(98),
+			expectedErr: context.DeadlineExceeded,
+		},
+		{
+			name:        "validator not found",
+			expectedErr: ErrValidatorNotFound,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			getValidators := func(_ context.Context, node *Node, nodeIDs []ids.NodeID) ([]platformvm.ClientPermissionlessValidator, error) {
+				require.Equal(nodes[0], node)
+				require.Equal([]ids.NodeID{validator}, nodeIDs)
+				return test.validators, nil
+			}
+			getUptime := func(ctx context.Context, nodeID ids.NodeID) (float64, error) {
+				return network.getUptime(ctx, nodeID, getValidators)
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+
+			err := assertUptimeAbove(ctx, validator, minUptime, getUptime)
+			require.ErrorIs(err, test.expectedErr)
+		})
+	}
+}
+
+func TestCheckHeightsConverged(t *testing.T) {
+	nodeIDs := []ids.NodeID{
+		ids.GenerateTestNodeID(),
+		ids.GenerateTestNodeID(),
+		ids.GenerateTestNodeID(),
+		ids.GenerateTestNodeID(),
+	}
+	tests := []struct {
+		name        string
+		heights     []uint64
+		expectedErr error
+	}{
+		{
+			name:    "same height",
+			heights: []uint64{5, 5, 5, 5},
+		},
+		{
+			name:    "within one block",
+			heights: []uint64{5, 6, 5, 4},
+		},
+		{
+			name:        "lagging node",
+			heights:     []uint64{5, 5, 5, 3},
+			expectedErr: errHeightsDiverged,
+		},
+		{
+			name:        "leading node",
+			heights:     []uint64{5, 7, 5, 5},
+			expectedErr: errHeightsDiverged,
+		},
+		{
+			name:        "ties resolved to the highest height",
+			heights:     []uint64{2, 2, 5, 5},
+			expectedErr: errHeightsDiverged,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := checkHeightsConverged(nodeIDs, test.heights)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestGetChainHeights(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		nodes   = newTestRunningNodes(t, 3)
+		network = &Network{Nodes: append(nodes, NewNodesOrPanic(1)...)}
+		errTest = errors.New("non-nil error")
+	)
+	getHeight := func(_ context.Context, node *Node) (uint64, error) {
+		switch node {
+		case nodes[0]:
+			return 10, nil
+		case nodes[1]:
+			return 11, nil
+		default:
+			return 0, errTest
+		}
+	}
+
+	heights, err := network.getChainHeights(context.Background(), logging.NoLog{}, getHeight)
+	require.NoError(err)
+	require.Equal(
+		map[ids.NodeID]uint64{
+			nodes[0].NodeID: 10,
+			nodes[1].NodeID: 11,
+			nodes[2].NodeID: 0,
+		},
+		heights,
+	)
+
+	_, err = (&Network{}).getChainHeights(context.Background(), logging.NoLog{}, getHeight)
+	require.ErrorIs(err, errNoRunningNodes)
+}
@@ -77,7 +77,7 @@ func (s *Subnet) GetWallet(ctx context.Context, uri string) (*primary.Wallet, er
 	// Only fetch the subnet transaction if a subnet ID is present. This won't be true when
 	// the wallet is first used to create the subnet.
 	subnetIDs := []ids.ID{}
-	if s.SubnetID != ids.Empty {
+	if !s.SubnetID.IsZero() {
 		subnetIDs = append(subnetIDs, s.SubnetID)
 	}
 
@@ -130,6 +130,11 @@ func (s *Subnet) CreateChains(ctx context.Context, log logging.Logger, uri strin
 	)
 
 	for _, chain := range s.Chains {
+		if !chain.ChainID.IsZero() {
+			// The chain was already created
+			continue
+		}
+
 		createChainTx, err := pWallet.IssueCreateChainTx(
 			s.SubnetID,
 			chain.Genesis,
@@ -152,7 +157,8 @@ func (s *Subnet) CreateChains(ctx context.Context, log logging.Logger, uri strin
 	return nil
 }
 
-// Add validators to the subnet
+// Add validators to the subnet. Nodes that already validate the subnet are
+// skipped.
 func (s *Subnet) AddValidators(ctx context.Context, log logging.Logger, apiURI string, nodes ...*Node) error {
 	wallet, err := s.GetWallet(ctx, apiURI)
 	if err != nil {
@@ -171,8 +177,22 @@ func (s *Subnet) AddValidators(ctx context.Context, log logging.Logger, apiURI s
 		endTimes[validator.NodeID] = validator.EndTime
 	}
 
+	subnetValidators, err := pvmClient.GetCurrentValidators(ctx, s.SubnetID, nil)
+	if err != nil {
+		return err
+	}
+	subnetValidatorIDs := set.NewSet[ids.NodeID](len(subnetValidators))
+	for _, validator := range subnetValidators {
+		subnetValidatorIDs.Add(validator.NodeID)
+	}
+
 	startTime := time.Now().Add(DefaultValidatorStartTimeDiff)
 	for _, node := range nodes {
+		if subnetValidatorIDs.Contains(node.NodeID) {
+			// The node was already added as a validator
+			continue
+		}
+
 		endTime, ok := endTimes[node.NodeID]
 		if !ok {
 			return fmt.Errorf("failed to find end time for %s", node.NodeID)
@@ -215,7 +235,7 @@ func (s *Subnet) Write(subnetDir string) error {
 	// without their chains having been created (i.e. chains will have
 	// empty IDs), use the absence of chain IDs as a prompt for a
 	// subnet name uniqueness check.
-	if len(s.Chains) > 0 && s.Chains[0].ChainID == ids.Empty {
+	if len(s.Chains) > 0 && s.Chains[0].ChainID.IsZero() {
 		_, err := os.Stat(tmpnetConfigPath)
 		if err != nil && !os.IsNotExist(err) {
 			return err
@@ -237,6 +257,17 @@ func (s *Subnet) Write(subnetDir string) error {
 	return nil
 }
 
+// hasUncreatedChains indicates whether at least one of the subnet's chains
+// has not been created.
+func (s *Subnet) hasUncreatedChains() bool {
+	for _, chain := range s.Chains {
+		if chain.ChainID.IsZero() {
+			return true
+		}
+	}
+	return false
+}
+
 // HasChainConfig indicates whether at least one of the subnet's
 // chains have explicit configuration. This can be used to determine
 // whether validator restart is required after chain creation to
@@ -250,6 +281,45 @@ func (s *Subnet) HasChainConfig() bool {
 	return false
 }
 
+// subnetCreator performs the operations against a running network that are
+// required to create a subnet. It allows subnet creation to be tested without
+// a running network.
+type subnetCreator interface {
+	createSubnet(ctx context.Context, subnet *Subnet) error
+	addValidators(ctx context.Context, log logging.Logger, subnet *Subnet, nodes []*Node) error
+	waitForActiveValidators(ctx context.Context, log logging.Logger, subnet *Subnet) error
+	createChains(ctx context.Context, log logging.Logger, subnet *Subnet) error
+}
+
+// apiSubnetCreator creates subnets via the API of the node at uri.
+type apiSubnetCreator struct {
+	uri          string
+	pChainClient platformvm.Client
+}
+
+func newAPISubnetCreator(uri string) *apiSubnetCreator {
+	return &apiSubnetCreator{
+		uri:          uri,
+		pChainClient: platformvm.NewClient(uri),
+	}
+}
+
+func (c *apiSubnetCreator) createSubnet(ctx context.Context, subnet *Subnet) error {
+	return subnet.Create(ctx, c.uri)
+}
+
+func (c *apiSubnetCreator) addValidators(ctx context.Context, log logging.Logger, subnet *Subnet, nodes []*Node) error {
+	return subnet.AddValidators(ctx, log, c.uri, nodes...)
+}
+
+func (c *apiSubnetCreator) waitForActiveValidators(ctx context.Context, log logging.Logger, subnet *Subnet) error {
+	return WaitForActiveValidators(ctx, log, c.pChainClient, subnet)
+}
+
+func (c *apiSubnetCreator) createChains(ctx context.Context, log logging.Logger, subnet *Subnet) error {
+	return subnet.CreateChains(ctx, log, c.uri)
+}
+
 func WaitForActiveValidators(
 	ctx context.Context,
 	log logging.Logger,
@@ -19,13 +19,15 @@ const failNowMessage = "SimpleTestContext.FailNow called"
 
 type SimpleTestContext struct {
 	log           logging.Logger
+	metrics       *TestMetrics
 	cleanupFuncs  []func()
 	cleanupCalled bool
 }
 
 func NewTestContext(log logging.Logger) *SimpleTestContext {
 	return &SimpleTestContext{
-		log: log,
+		log:     log,
+		metrics: NewTestMetrics(log),
 	}
 }
 
@@ -111,3 +113,15 @@ func (tc *SimpleTestContext) WithDefaultContext() common.Option {
 func (tc *SimpleTestContext) Eventually(condition func() bool, waitFor time.Duration, tick time.Duration, msg string) {
 	require.Eventually(tc, condition, waitFor, tick, msg)
 }
+
+func (tc *SimpleTestContext) RecordMetric(name string, value float64) {
+	tc.metrics.RecordMetric(name, value)
+}
+
+func (tc *SimpleTestContext) RecordDuration(name string, d time.Duration) {
+	tc.metrics.RecordDuration(name, d)
+}
+
+func (tc *SimpleTestContext) ExportMetrics(path string) error {
+	return tc.metrics.ExportMetrics(path)
+}
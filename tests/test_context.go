@@ -34,3 +34,10 @@ type TestContext interface {
 	// Ensures compatibility with require.Eventually
 	Eventually(condition func() bool, waitFor time.Duration, tick time.Duration, msg string)
 }
+
+// Attacher is optionally implemented by a TestContext that can write an
+// artifact (e.g. a block dump or genesis file) to a test-specific dir to
+// simplify troubleshooting of test failures.
+type Attacher interface {
+	Attach(name string, data []byte, contentType string)
+}
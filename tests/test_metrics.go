@@ -0,0 +1,102 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package tests
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/expfmt"
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/perms"
+)
+
+var (
+	_ MetricsRecorder = (*TestMetrics)(nil)
+	_ MetricsRecorder = (*SimpleTestContext)(nil)
+)
+
+// MetricsRecorder records test-level metrics. It is optionally implemented by
+// a TestContext whose lifetime is a single test (e.g. SimpleTestContext). Where
+// a TestContext may be shared between tests, as is the case for ginkgo specs, a
+// TestMetrics should be created for each test instead.
+type MetricsRecorder interface {
+	RecordMetric(name string, value float64)
+	RecordDuration(name string, d time.Duration)
+	ExportMetrics(path string) error
+}
+
+// TestMetrics records test-level metrics (e.g. transaction throughput or time
+// to finality) to a prometheus registry local to a single test.
+type TestMetrics struct {
+	log logging.Logger
+
+	lock     sync.Mutex
+	registry *prometheus.Registry
+	gauges   map[string]prometheus.Gauge
+}
+
+func NewTestMetrics(log logging.Logger) *TestMetrics {
+	return &TestMetrics{
+		log:      log,
+		registry: prometheus.NewRegistry(),
+		gauges:   make(map[string]prometheus.Gauge),
+	}
+}
+
+// RecordMetric sets the value of the named metric. A metric whose name is not
+// a valid prometheus metric name will be logged and otherwise ignored.
+func (m *TestMetrics) RecordMetric(name string, value float64) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	gauge, ok := m.gauges[name]
+	if !ok {
+		gauge = prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: name,
+			Help: "test-level metric recorded by " + name,
+		})
+		if err := m.registry.Register(gauge); err != nil {
+			m.log.Error("failed to register test metric",
+				zap.String("name", name),
+				zap.Error(err),
+			)
+			return
+		}
+		m.gauges[name] = gauge
+	}
+	gauge.Set(value)
+}
+
+// RecordDuration sets the value of the named metric to the provided duration
+// in seconds.
+func (m *TestMetrics) RecordDuration(name string, d time.Duration) {
+	m.RecordMetric(name, d.Seconds())
+}
+
+// ExportMetrics writes the recorded metrics to the provided path in the
+// prometheus text exposition format.
+func (m *TestMetrics) ExportMetrics(path string) error {
+	metricFamilies, err := m.registry.Gather()
+	if err != nil {
+		return fmt.Errorf("failed to gather test metrics: %w", err)
+	}
+
+	var buf bytes.Buffer
+	for _, metricFamily := range metricFamilies {
+		if _, err := expfmt.MetricFamilyToText(&buf, metricFamily); err != nil {
+			return fmt.Errorf("failed to encode test metric %q: %w", metricFamily.GetName(), err)
+		}
+	}
+	if err := os.WriteFile(path, buf.Bytes(), perms.ReadWrite); err != nil {
+		return fmt.Errorf("failed to write test metrics to %s: %w", path, err)
+	}
+	return nil
+}
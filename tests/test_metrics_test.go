@@ -0,0 +1,35 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+func TestExportMetrics(t *testing.T) {
+	require := require.New(t)
+
+	tc := NewTestContext(logging.NoLog{})
+	tc.RecordMetric("txs_per_second", 42)
+	tc.RecordDuration("time_to_finality", 1500*time.Millisecond)
+	// Invalid names are ignored rather than failing the test
+	tc.RecordMetric("invalid name", 1)
+
+	path := filepath.Join(t.TempDir(), "metrics.txt")
+	require.NoError(tc.ExportMetrics(path))
+
+	bytes, err := os.ReadFile(path)
+	require.NoError(err)
+	contents := string(bytes)
+	require.Contains(contents, "txs_per_second 42")
+	require.Contains(contents, "time_to_finality 1.5")
+	require.NotContains(contents, "invalid name")
+}
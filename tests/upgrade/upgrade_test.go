@@ -5,16 +5,20 @@ package upgrade
 
 import (
 	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
+	"os/exec"
 	"testing"
 	"time"
 
 	"github.com/onsi/ginkgo/v2"
 	"github.com/stretchr/testify/require"
 
+	"github.com/ava-labs/avalanchego/api/info"
 	"github.com/ava-labs/avalanchego/tests/fixture/e2e"
 	"github.com/ava-labs/avalanchego/tests/fixture/tmpnet"
+	"github.com/ava-labs/avalanchego/version"
 )
 
 func TestUpgrade(t *testing.T) {
@@ -84,17 +88,21 @@ var _ = ginkgo.Describe("[Upgrade]", func() {
 			false, /* reuseNetwork */
 		)
 
+		tc.By(fmt.Sprintf("determining the version of the %q binary", avalancheGoExecPathToUpgradeTo))
+		versionJSON, err := exec.Command(avalancheGoExecPathToUpgradeTo, "--version-json").Output()
+		require.NoError(err)
+		expectedVersions := &version.Versions{}
+		require.NoError(json.Unmarshal(versionJSON, expectedVersions))
+
 		tc.By(fmt.Sprintf("restarting all nodes with %q binary", avalancheGoExecPathToUpgradeTo))
 		for _, node := range network.Nodes {
-			tc.By(fmt.Sprintf("restarting node %q with %q binary", node.NodeID, avalancheGoExecPathToUpgradeTo))
-			require.NoError(node.Stop(tc.DefaultContext()))
-
-			node.RuntimeConfig.AvalancheGoPath = avalancheGoExecPathToUpgradeTo
-
-			require.NoError(network.StartNode(tc.DefaultContext(), tc.Log(), node))
+			tc.By(fmt.Sprintf("upgrading node %q to %q binary", node.NodeID, avalancheGoExecPathToUpgradeTo))
+			require.NoError(network.UpgradeNode(tc.DefaultContext(), tc.Log(), node.NodeID, avalancheGoExecPathToUpgradeTo))
 
-			tc.By(fmt.Sprintf("waiting for node %q to report healthy after restart", node.NodeID))
-			e2e.WaitForHealthy(tc, node)
+			tc.By(fmt.Sprintf("checking that node %q reports the upgraded version", node.NodeID))
+			nodeVersion, err := info.NewClient(e2e.GetLocalURI(tc, node)).GetNodeVersion(tc.DefaultContext())
+			require.NoError(err)
+			require.Equal(expectedVersions.Application, nodeVersion.Version)
 		}
 
 		_ = e2e.CheckBootstrapIsPossible(tc, network)
@@ -5,49 +5,169 @@ package rpcsigner
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/ava-labs/avalanchego/utils/crypto/bls"
 
 	pb "github.com/ava-labs/avalanchego/proto/pb/signer"
 )
 
-var _ bls.Signer = (*Client)(nil)
+var (
+	_ bls.Signer = (*Client)(nil)
+
+	errUnknownURL = errors.New("signer url is unknown")
+)
 
 type Client struct {
+	// url and opts are only populated for clients that dialed the signer
+	// themselves and are used to re-establish the connection.
+	url  string
+	opts []Option
+
+	metrics *metrics
+
+	lock   sync.RWMutex
 	client pb.SignerClient
 	conn   *grpc.ClientConn
 	pk     *bls.PublicKey
 }
 
-func NewClient(ctx context.Context, conn *grpc.ClientConn) (*Client, error) {
-	client := pb.NewSignerClient(conn)
+type options struct {
+	dialOpts   []grpc.DialOption
+	registerer prometheus.Registerer
+}
 
-	pubkeyResponse, err := client.PublicKey(ctx, &pb.PublicKeyRequest{})
+type Option func(*options)
+
+// WithDialOptions appends the provided options to those used to dial the
+// signer.
+func WithDialOptions(dialOpts ...grpc.DialOption) Option {
+	return func(o *options) {
+		o.dialOpts = append(o.dialOpts, dialOpts...)
+	}
+}
+
+// WithRegisterer registers the client's metrics with [registerer].
+func WithRegisterer(registerer prometheus.Registerer) Option {
+	return func(o *options) {
+		o.registerer = registerer
+	}
+}
+
+// NewClientWithContext connects to the signer at [url] and fetches its public
+// key. Construction blocks until the connection is ready, and returns early
+// with an error if [ctx] is cancelled or its deadline is exceeded.
+//
+// The returned function closes the connection to the signer.
+func NewClientWithContext(ctx context.Context, url string, opts ...Option) (*Client, func() error, error) {
+	o := newOptions(opts)
+	metrics, err := newMetrics(o.registerer)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	conn, client, pk, err := connect(ctx, url, o)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &Client{
+		url:     url,
+		opts:    opts,
+		metrics: metrics,
+		client:  client,
+		conn:    conn,
+		pk:      pk,
+	}
+	return c, c.close, nil
+}
+
+// NewClient returns a client of the signer connected to by [conn]. If
+// [registerer] is nil, the client's metrics are not registered.
+func NewClient(
+	ctx context.Context,
+	conn *grpc.ClientConn,
+	registerer prometheus.Registerer,
+) (*Client, error) {
+	metrics, err := newMetrics(registerer)
 	if err != nil {
 		return nil, err
 	}
 
-	pkBytes := pubkeyResponse.GetPublicKey()
-	pk, err := bls.PublicKeyFromCompressedBytes(pkBytes)
+	client := pb.NewSignerClient(conn)
+	pk, err := fetchPublicKey(ctx, client)
 	if err != nil {
 		return nil, err
 	}
 
 	return &Client{
-		client: client,
-		conn:   conn,
-		pk:     pk,
+		metrics: metrics,
+		client:  client,
+		conn:    conn,
+		pk:      pk,
 	}, nil
 }
 
+// Reconnect replaces the connection to the signer with a new connection to the
+// original url and refreshes the public key. The existing connection is only
+// closed once the new connection has been established, so the client remains
+// usable if reconnecting fails.
+func (c *Client) Reconnect(ctx context.Context) error {
+	if c.url == "" {
+		return errUnknownURL
+	}
+
+	conn, client, pk, err := connect(ctx, c.url, newOptions(c.opts))
+	if err != nil {
+		return err
+	}
+
+	c.lock.Lock()
+	oldConn := c.conn
+	c.client = client
+	c.conn = conn
+	c.pk = pk
+	c.lock.Unlock()
+
+	if oldConn != nil {
+		// The old connection may already be unusable, so failing to close it
+		// isn't treated as a failure to reconnect.
+		_ = oldConn.Close()
+	}
+	return nil
+}
+
 func (c *Client) PublicKey() *bls.PublicKey {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
 	return c.pk
 }
 
 func (c *Client) Sign(message []byte) (*bls.Signature, error) {
-	resp, err := c.client.Sign(context.TODO(), &pb.SignRequest{Message: message})
+	start := time.Now()
+	sig, err := c.sign(message)
+	c.metrics.observe(signMethod, start, err)
+	return sig, err
+}
+
+func (c *Client) SignProofOfPossession(message []byte) (*bls.Signature, error) {
+	start := time.Now()
+	sig, err := c.signProofOfPossession(message)
+	c.metrics.observe(signProofOfPossessionMethod, start, err)
+	return sig, err
+}
+
+func (c *Client) sign(message []byte) (*bls.Signature, error) {
+	resp, err := c.signerClient().Sign(context.TODO(), &pb.SignRequest{Message: message})
 	if err != nil {
 		return nil, err
 	}
@@ -56,8 +176,8 @@ func (c *Client) Sign(message []byte) (*bls.Signature, error) {
 	return bls.SignatureFromBytes(signature)
 }
 
-func (c *Client) SignProofOfPossession(message []byte) (*bls.Signature, error) {
-	resp, err := c.client.SignProofOfPossession(context.TODO(), &pb.SignProofOfPossessionRequest{Message: message})
+func (c *Client) signProofOfPossession(message []byte) (*bls.Signature, error) {
+	resp, err := c.signerClient().SignProofOfPossession(context.TODO(), &pb.SignProofOfPossessionRequest{Message: message})
 	if err != nil {
 		return nil, err
 	}
@@ -65,3 +185,77 @@ func (c *Client) SignProofOfPossession(message []byte) (*bls.Signature, error) {
 
 	return bls.SignatureFromBytes(signature)
 }
+
+func (c *Client) signerClient() pb.SignerClient {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.client
+}
+
+func (c *Client) close() error {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.conn.Close()
+}
+
+func newOptions(opts []Option) *options {
+	o := &options{
+		dialOpts: []grpc.DialOption{
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		},
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// connect dials the signer at [url] and fetches its public key.
+func connect(
+	ctx context.Context,
+	url string,
+	o *options,
+) (*grpc.ClientConn, pb.SignerClient, *bls.PublicKey, error) {
+	conn, err := grpc.NewClient(url, o.dialOpts...)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to create signer connection: %w", err)
+	}
+	if err := waitForReady(ctx, conn); err != nil {
+		_ = conn.Close()
+		return nil, nil, nil, fmt.Errorf("failed to connect to signer at %s: %w", url, err)
+	}
+
+	client := pb.NewSignerClient(conn)
+	pk, err := fetchPublicKey(ctx, client)
+	if err != nil {
+		_ = conn.Close()
+		return nil, nil, nil, err
+	}
+	return conn, client, pk, nil
+}
+
+// waitForReady blocks until [conn] is ready or [ctx] is done.
+func waitForReady(ctx context.Context, conn *grpc.ClientConn) error {
+	conn.Connect()
+	for {
+		state := conn.GetState()
+		if state == connectivity.Ready {
+			return nil
+		}
+		if !conn.WaitForStateChange(ctx, state) {
+			return ctx.Err()
+		}
+	}
+}
+
+func fetchPublicKey(ctx context.Context, client pb.SignerClient) (*bls.PublicKey, error) {
+	pubkeyResponse, err := client.PublicKey(ctx, &pb.PublicKeyRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	pkBytes := pubkeyResponse.GetPublicKey()
+	return bls.PublicKeyFromCompressedBytes(pkBytes)
+}
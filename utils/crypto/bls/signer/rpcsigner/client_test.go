@@ -6,8 +6,12 @@ package rpcsigner
 import (
 	"context"
 	"errors"
+	"net"
 	"testing"
+	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 
@@ -27,7 +31,11 @@ func newSigner(t *testing.T) *Client {
 	localSigner, err := localsigner.New()
 	require.NoError(t, err)
 
+	metrics, err := newMetrics(nil)
+	require.NoError(t, err)
+
 	return &Client{
+		metrics: metrics,
 		client: &stubClient{
 			signer: localSigner,
 		},
@@ -35,6 +43,170 @@ func newSigner(t *testing.T) *Client {
 	}
 }
 
+type testServer struct {
+	signer.UnimplementedSignerServer
+
+	signer *localsigner.LocalSigner
+}
+
+func (s *testServer) PublicKey(context.Context, *signer.PublicKeyRequest) (*signer.PublicKeyResponse, error) {
+	return &signer.PublicKeyResponse{
+		PublicKey: bls.PublicKeyToCompressedBytes(s.signer.PublicKey()),
+	}, nil
+}
+
+func (s *testServer) Sign(_ context.Context, in *signer.SignRequest) (*signer.SignResponse, error) {
+	sig, err := s.signer.Sign(in.Message)
+	if err != nil {
+		return nil, err
+	}
+	return &signer.SignResponse{
+		Signature: bls.SignatureToBytes(sig),
+	}, nil
+}
+
+// startTestServer serves a signer on a local port and returns its address.
+func startTestServer(t testing.TB, localSigner *localsigner.LocalSigner) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	signer.RegisterSignerServer(server, &testServer{signer: localSigner})
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	return listener.Addr().String()
+}
+
+func TestNewClientWithContext(t *testing.T) {
+	require := require.New(t)
+
+	localSigner, err := localsigner.New()
+	require.NoError(err)
+	url := startTestServer(t, localSigner)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client, closeFn, err := NewClientWithContext(ctx, url)
+	require.NoError(err)
+	defer func() {
+		require.NoError(closeFn())
+	}()
+	require.Equal(localSigner.PublicKey(), client.PublicKey())
+
+	sig, err := client.Sign(validSignatureMsg)
+	require.NoError(err)
+	require.True(bls.Verify(client.PublicKey(), sig, validSignatureMsg))
+}
+
+func TestNewClientWithContextCancelled(t *testing.T) {
+	require := require.New(t)
+
+	// The listener accepts connections but never completes the handshake, so
+	// construction can only return once the context is done.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(err)
+	defer listener.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	client, closeFn, err := NewClientWithContext(ctx, listener.Addr().String())
+	require.ErrorIs(err, context.DeadlineExceeded)
+	require.Nil(client)
+	require.Nil(closeFn)
+	require.Less(time.Since(start), 5*time.Second)
+}
+
+func TestReconnect(t *testing.T) {
+	require := require.New(t)
+
+	localSigner, err := localsigner.New()
+	require.NoError(err)
+	url := startTestServer(t, localSigner)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client, closeFn, err := NewClientWithContext(ctx, url)
+	require.NoError(err)
+	defer func() {
+		require.NoError(closeFn())
+	}()
+
+	// Simulate a connection failure
+	require.NoError(client.conn.Close())
+	_, err = client.Sign(validSignatureMsg)
+	require.Error(err) //nolint:forbidigo // the error is returned by grpc
+
+	require.NoError(client.Reconnect(ctx))
+	require.Equal(localSigner.PublicKey(), client.PublicKey())
+
+	sig, err := client.Sign(validSignatureMsg)
+	require.NoError(err)
+	require.True(bls.Verify(client.PublicKey(), sig, validSignatureMsg))
+}
+
+func TestReconnectUnknownURL(t *testing.T) {
+	client := newSigner(t)
+	err := client.Reconnect(context.Background())
+	require.ErrorIs(t, err, errUnknownURL)
+}
+
+func TestMetrics(t *testing.T) {
+	require := require.New(t)
+
+	localSigner, err := localsigner.New()
+	require.NoError(err)
+	url := startTestServer(t, localSigner)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	registry := prometheus.NewRegistry()
+	client, closeFn, err := NewClientWithContext(ctx, url, WithRegisterer(registry))
+	require.NoError(err)
+	defer func() {
+		require.NoError(closeFn())
+	}()
+
+	_, err = client.Sign(validSignatureMsg)
+	require.NoError(err)
+	_, err = client.Sign(validSignatureMsg)
+	require.NoError(err)
+	// The test server doesn't implement SignProofOfPossession
+	_, err = client.SignProofOfPossession(validSignatureMsg)
+	require.Error(err) //nolint:forbidigo // the error is returned by grpc
+
+	require.Equal(1, testutil.CollectAndCount(client.metrics.errors))
+	require.InDelta(1, testutil.ToFloat64(client.metrics.errors.WithLabelValues(signProofOfPossessionMethod)), 0)
+
+	families, err := registry.Gather()
+	require.NoError(err)
+	sampleCounts := make(map[string]uint64)
+	for _, family := range families {
+		if family.GetName() != "signer_request_duration" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				sampleCounts[label.GetValue()] = metric.GetHistogram().GetSampleCount()
+			}
+		}
+	}
+	require.Equal(
+		map[string]uint64{
+			signMethod:                  2,
+			signProofOfPossessionMethod: 1,
+		},
+		sampleCounts,
+	)
+}
+
 func TestValidSignature(t *testing.T) {
 	client := newSigner(t)
 	sig, err := client.Sign(validSignatureMsg)
@@ -0,0 +1,70 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package rpcsigner
+
+import (
+	"errors"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	methodLabel = "method"
+
+	signMethod                  = "sign"
+	signProofOfPossessionMethod = "sign_proof_of_possession"
+)
+
+var methodLabels = []string{methodLabel}
+
+type metrics struct {
+	duration *prometheus.HistogramVec
+	errors   *prometheus.CounterVec
+}
+
+// newMetrics returns the metrics of a signer client. If [registerer] is nil,
+// the metrics are recorded but never exposed.
+func newMetrics(registerer prometheus.Registerer) (*metrics, error) {
+	m := &metrics{
+		duration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name: "signer_request_duration",
+				Help: "time (in seconds) taken by the remote signer to respond",
+				Buckets: []float64{
+					.001, // 1ms
+					.005, // 5ms
+					.01,  // 10ms
+					.05,  // 50ms
+					.1,   // 100ms
+				},
+			},
+			methodLabels,
+		),
+		errors: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "signer_request_errors",
+				Help: "number of failed requests to the remote signer",
+			},
+			methodLabels,
+		),
+	}
+	if registerer == nil {
+		return m, nil
+	}
+
+	err := errors.Join(
+		registerer.Register(m.duration),
+		registerer.Register(m.errors),
+	)
+	return m, err
+}
+
+func (m *metrics) observe(method string, start time.Time, err error) {
+	labels := prometheus.Labels{methodLabel: method}
+	m.duration.With(labels).Observe(time.Since(start).Seconds())
+	if err != nil {
+		m.errors.With(labels).Inc()
+	}
+}
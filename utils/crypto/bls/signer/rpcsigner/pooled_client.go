@@ -0,0 +1,90 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package rpcsigner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+)
+
+const pooledConnectTimeout = 10 * time.Second
+
+var (
+	_ bls.Signer = (*PooledClient)(nil)
+
+	errInvalidPoolSize     = errors.New("pool size must be positive")
+	errPublicKeyMismatched = errors.New("signer connections reported different public keys")
+)
+
+// PooledClient distributes signing requests across multiple connections to the
+// same signer.
+type PooledClient struct {
+	clients []*Client
+	next    atomic.Uint64
+}
+
+// NewPooledClient opens [poolSize] connections to the signer at [url]. Each
+// connection must be established within [pooledConnectTimeout].
+//
+// The returned function closes all the connections to the signer.
+func NewPooledClient(url string, poolSize int) (*PooledClient, func() error, error) {
+	if poolSize <= 0 {
+		return nil, nil, fmt.Errorf("%w: %d", errInvalidPoolSize, poolSize)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), pooledConnectTimeout)
+	defer cancel()
+
+	var (
+		clients  = make([]*Client, 0, poolSize)
+		closeFns = make([]func() error, 0, poolSize)
+		closeAll = func() error {
+			errs := make([]error, len(closeFns))
+			for i, closeFn := range closeFns {
+				errs[i] = closeFn()
+			}
+			return errors.Join(errs...)
+		}
+	)
+	for i := 0; i < poolSize; i++ {
+		client, closeFn, err := NewClientWithContext(ctx, url)
+		if err != nil {
+			_ = closeAll()
+			return nil, nil, err
+		}
+		clients = append(clients, client)
+		closeFns = append(closeFns, closeFn)
+
+		if !clients[0].PublicKey().Equals(client.PublicKey()) {
+			_ = closeAll()
+			return nil, nil, errPublicKeyMismatched
+		}
+	}
+	return &PooledClient{
+		clients: clients,
+	}, closeAll, nil
+}
+
+func (c *PooledClient) PublicKey() *bls.PublicKey {
+	return c.clients[0].PublicKey()
+}
+
+func (c *PooledClient) Sign(message []byte) (*bls.Signature, error) {
+	return c.nextClient().Sign(message)
+}
+
+func (c *PooledClient) SignProofOfPossession(message []byte) (*bls.Signature, error) {
+	return c.nextClient().SignProofOfPossession(message)
+}
+
+// nextClient returns the connections in round-robin order.
+func (c *PooledClient) nextClient() *Client {
+	i := c.next.Add(1) - 1
+	return c.clients[i%uint64(len(c.clients))]
+}
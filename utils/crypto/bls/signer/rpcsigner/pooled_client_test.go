@@ -0,0 +1,103 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package rpcsigner
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls/signer/localsigner"
+)
+
+func TestPooledClient(t *testing.T) {
+	require := require.New(t)
+
+	localSigner, err := localsigner.New()
+	require.NoError(err)
+	url := startTestServer(t, localSigner)
+
+	const poolSize = 3
+	client, closeFn, err := NewPooledClient(url, poolSize)
+	require.NoError(err)
+	defer func() {
+		require.NoError(closeFn())
+	}()
+	require.Len(client.clients, poolSize)
+	require.Equal(localSigner.PublicKey(), client.PublicKey())
+
+	for i := 0; i < 2*poolSize; i++ {
+		sig, err := client.Sign(validSignatureMsg)
+		require.NoError(err)
+		require.True(bls.Verify(client.PublicKey(), sig, validSignatureMsg))
+	}
+	require.Equal(uint64(2*poolSize), client.next.Load())
+}
+
+func TestPooledClientInvalidPoolSize(t *testing.T) {
+	_, _, err := NewPooledClient("127.0.0.1:0", 0)
+	require.ErrorIs(t, err, errInvalidPoolSize)
+}
+
+func BenchmarkConcurrentSign(b *testing.B) {
+	const (
+		numConcurrentSigns = 100
+		poolSize           = 4
+	)
+
+	localSigner, err := localsigner.New()
+	require.NoError(b, err)
+	url := startTestServer(b, localSigner)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	singleClient, closeSingle, err := NewClientWithContext(ctx, url)
+	require.NoError(b, err)
+	defer func() {
+		require.NoError(b, closeSingle())
+	}()
+
+	pooledClient, closePooled, err := NewPooledClient(url, poolSize)
+	require.NoError(b, err)
+	defer func() {
+		require.NoError(b, closePooled())
+	}()
+
+	benchmarks := []struct {
+		name   string
+		signer bls.Signer
+	}{
+		{
+			name:   "single connection",
+			signer: singleClient,
+		},
+		{
+			name:   "pooled connections",
+			signer: pooledClient,
+		},
+	}
+	for _, benchmark := range benchmarks {
+		b.Run(benchmark.name, func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				var wg sync.WaitGroup
+				for i := 0; i < numConcurrentSigns; i++ {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+
+						if _, err := benchmark.signer.Sign(validSignatureMsg); err != nil {
+							b.Error(err)
+						}
+					}()
+				}
+				wg.Wait()
+			}
+		})
+	}
+}
@@ -158,7 +158,7 @@ func (k *PublicKey) ToECDSA() *stdecdsa.PublicKey {
 }
 
 func (k *PublicKey) Address() ids.ShortID {
-	if k.addr == ids.ShortEmpty {
+	if k.addr.IsZero() {
 		addr, err := ids.ToShortID(hashing.PubkeyBytesToAddress(k.Bytes()))
 		if err != nil {
 			panic(err)
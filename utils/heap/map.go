@@ -93,12 +93,75 @@ func (m *Map[K, V]) Get(k K) (V, bool) {
 	return utils.Zero[V](), false
 }
 
+// UpdateKey moves the value at oldKey to newKey. Because the value is
+// unchanged, its position in the heap is preserved. Returns false, without
+// modifying the heap, if oldKey isn't in the heap or if newKey is already used
+// by a different entry.
+func (m *Map[K, V]) UpdateKey(oldKey, newKey K) bool {
+	i, ok := m.queue.index[oldKey]
+	if !ok {
+		return false
+	}
+	if oldKey == newKey {
+		return true
+	}
+	if _, ok := m.queue.index[newKey]; ok {
+		return false
+	}
+
+	m.queue.entries[i].k = newKey
+	delete(m.queue.index, oldKey)
+	m.queue.index[newKey] = i
+	return true
+}
+
 func (m *Map[K, V]) Fix(k K) {
 	if i, ok := m.queue.index[k]; ok {
 		heap.Fix(m.queue, i)
 	}
 }
 
+// Clone returns a shallow copy of the heap. Modifying the order of either heap
+// does not affect the other, however the values are not copied.
+func (m *Map[K, V]) Clone() Map[K, V] {
+	clone := Map[K, V]{
+		queue: &indexedQueue[K, V]{
+			queue: queue[entry[K, V]]{
+				entries: make([]entry[K, V], len(m.queue.entries)),
+				less:    m.queue.less,
+			},
+			index: make(map[K]int, len(m.queue.index)),
+		},
+	}
+	copy(clone.queue.entries, m.queue.entries)
+	for k, i := range m.queue.index {
+		clone.queue.index[k] = i
+	}
+	return clone
+}
+
+// Filter returns a new heap containing the entries whose values satisfy
+// predicate. The original heap is not modified.
+func (m *Map[K, V]) Filter(predicate func(V) bool) Map[K, V] {
+	filtered := Map[K, V]{
+		queue: &indexedQueue[K, V]{
+			queue: queue[entry[K, V]]{
+				less: m.queue.less,
+			},
+			index: make(map[K]int),
+		},
+	}
+	for _, e := range m.queue.entries {
+		if !predicate(e.v) {
+			continue
+		}
+		filtered.queue.index[e.k] = len(filtered.queue.entries)
+		filtered.queue.entries = append(filtered.queue.entries, e)
+	}
+	heap.Init(filtered.queue)
+	return filtered
+}
+
 type indexedQueue[K comparable, V any] struct {
 	queue[entry[K, V]]
 	index map[K]int
@@ -94,3 +94,135 @@ func TestMap(t *testing.T) {
 		})
 	}
 }
+
+func TestMapClone(t *testing.T) {
+	require := require.New(t)
+
+	h := NewMap[string, int](func(a, b int) bool {
+		return a < b
+	})
+	h.Push("a", 1)
+	h.Push("c", 3)
+	h.Push("b", 2)
+
+	clone := h.Clone()
+	require.Equal(h.Len(), clone.Len())
+
+	// Modifying the clone shouldn't modify the original
+	k, v, ok := clone.Pop()
+	require.True(ok)
+	require.Equal("a", k)
+	require.Equal(1, v)
+	clone.Push("d", 0)
+	_, ok = clone.Remove("c")
+	require.True(ok)
+
+	// Modifying the original shouldn't modify the clone
+	h.Push("e", 5)
+
+	for _, expected := range []entry[string, int]{
+		{k: "a", v: 1},
+		{k: "b", v: 2},
+		{k: "c", v: 3},
+		{k: "e", v: 5},
+	} {
+		k, v, ok := h.Pop()
+		require.True(ok)
+		require.Equal(expected.k, k)
+		require.Equal(expected.v, v)
+	}
+	require.Zero(h.Len())
+
+	for _, expected := range []entry[string, int]{
+		{k: "d", v: 0},
+		{k: "b", v: 2},
+	} {
+		k, v, ok := clone.Pop()
+		require.True(ok)
+		require.Equal(expected.k, k)
+		require.Equal(expected.v, v)
+	}
+	require.Zero(clone.Len())
+}
+
+func TestMapFilter(t *testing.T) {
+	require := require.New(t)
+
+	h := NewMap[string, int](func(a, b int) bool {
+		return a < b
+	})
+	h.Push("f", 6)
+	h.Push("a", 1)
+	h.Push("d", 4)
+	h.Push("b", 2)
+	h.Push("e", 5)
+	h.Push("c", 3)
+
+	even := h.Filter(func(v int) bool {
+		return v%2 == 0
+	})
+	require.Equal(6, h.Len())
+	require.Equal(3, even.Len())
+	require.False(even.Contains("a"))
+	require.True(even.Contains("d"))
+
+	// The filtered heap should be independently modifiable
+	even.Push("z", 0)
+	require.False(h.Contains("z"))
+
+	for _, expected := range []entry[string, int]{
+		{k: "z", v: 0},
+		{k: "b", v: 2},
+		{k: "d", v: 4},
+		{k: "f", v: 6},
+	} {
+		k, v, ok := even.Pop()
+		require.True(ok)
+		require.Equal(expected.k, k)
+		require.Equal(expected.v, v)
+	}
+	require.Zero(even.Len())
+
+	none := h.Filter(func(int) bool {
+		return false
+	})
+	require.Zero(none.Len())
+}
+
+func TestMapUpdateKey(t *testing.T) {
+	require := require.New(t)
+
+	h := NewMap[string, int](func(a, b int) bool {
+		return a < b
+	})
+	h.Push("a", 1)
+	h.Push("b", 2)
+	h.Push("c", 3)
+
+	require.False(h.UpdateKey("missing", "d"))
+	require.False(h.UpdateKey("a", "b"))
+	require.True(h.UpdateKey("a", "a"))
+
+	require.True(h.UpdateKey("b", "d"))
+	require.False(h.Contains("b"))
+	v, ok := h.Get("d")
+	require.True(ok)
+	require.Equal(2, v)
+
+	// The entry should be removable at its new key
+	v, ok = h.Remove("d")
+	require.True(ok)
+	require.Equal(2, v)
+	h.Push("d", 2)
+
+	for _, expected := range []entry[string, int]{
+		{k: "a", v: 1},
+		{k: "d", v: 2},
+		{k: "c", v: 3},
+	} {
+		k, v, ok := h.Pop()
+		require.True(ok)
+		require.Equal(expected.k, k)
+		require.Equal(expected.v, v)
+	}
+}
@@ -4,14 +4,17 @@
 package math
 
 import (
-	"errors"
-
 	"golang.org/x/exp/constraints"
+
+	"github.com/ava-labs/avalanchego/utils/units"
 )
 
 var (
-	ErrOverflow  = errors.New("overflow")
-	ErrUnderflow = errors.New("underflow")
+	// ErrOverflow and ErrUnderflow are shared with units, which can't import
+	// this package, so that checked arithmetic on units.AvaxPrice reports the
+	// same errors.
+	ErrOverflow  = units.ErrOverflow
+	ErrUnderflow = units.ErrUnderflow
 
 	// Deprecated: Add64 is deprecated. Use Add[uint64] instead.
 	Add64 = Add[uint64]
@@ -0,0 +1,53 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package units
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const avaxDenom = "AVAX"
+
+// AvaxAmount is an amount of nAVAX. It is displayed in AVAX and serialized to
+// JSON as a quoted integer number of nAVAX.
+type AvaxAmount uint64
+
+// String returns the amount in AVAX without a denomination, e.g. "1.5".
+func (a AvaxAmount) String() string {
+	whole := uint64(a) / Avax
+	frac := uint64(a) % Avax
+	if frac == 0 {
+		return strconv.FormatUint(whole, 10)
+	}
+	// Avax is 10^9 nAVAX, so the fractional part has at most 9 digits.
+	fracStr := strings.TrimRight(fmt.Sprintf("%09d", frac), "0")
+	return strconv.FormatUint(whole, 10) + "." + fracStr
+}
+
+// FormatWithDenom returns the amount in AVAX with its denomination, e.g.
+// "1.5 AVAX".
+func (a AvaxAmount) FormatWithDenom() string {
+	return a.String() + " " + avaxDenom
+}
+
+func (a AvaxAmount) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + strconv.FormatUint(uint64(a), 10) + `"`), nil
+}
+
+func (a *AvaxAmount) UnmarshalJSON(b []byte) error {
+	str := string(b)
+	if str == "null" {
+		return nil
+	}
+	if len(str) >= 2 {
+		if lastIndex := len(str) - 1; str[0] == '"' && str[lastIndex] == '"' {
+			str = str[1:lastIndex]
+		}
+	}
+	val, err := strconv.ParseUint(str, 10, 64)
+	*a = AvaxAmount(val)
+	return err
+}
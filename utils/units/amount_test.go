@@ -0,0 +1,75 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package units
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAvaxAmountFormat(t *testing.T) {
+	tests := []struct {
+		amount            AvaxAmount
+		expected          string
+		expectedWithDenom string
+	}{
+		{
+			amount:            0,
+			expected:          "0",
+			expectedWithDenom: "0 AVAX",
+		},
+		{
+			amount:            AvaxAmount(Avax),
+			expected:          "1",
+			expectedWithDenom: "1 AVAX",
+		},
+		{
+			amount:            AvaxAmount(Avax + 500*MilliAvax),
+			expected:          "1.5",
+			expectedWithDenom: "1.5 AVAX",
+		},
+		{
+			amount:            AvaxAmount(NanoAvax),
+			expected:          "0.000000001",
+			expectedWithDenom: "0.000000001 AVAX",
+		},
+		{
+			amount:            math.MaxUint64,
+			expected:          "18446744073.709551615",
+			expectedWithDenom: "18446744073.709551615 AVAX",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.expected, func(t *testing.T) {
+			require := require.New(t)
+
+			require.Equal(test.expected, test.amount.String())
+			require.Equal(test.expectedWithDenom, test.amount.FormatWithDenom())
+		})
+	}
+}
+
+func TestAvaxAmountJSON(t *testing.T) {
+	require := require.New(t)
+
+	amount := AvaxAmount(Avax + 500*MilliAvax)
+	bytes, err := json.Marshal(amount)
+	require.NoError(err)
+	require.JSONEq(`"1500000000"`, string(bytes))
+
+	var parsed AvaxAmount
+	require.NoError(json.Unmarshal(bytes, &parsed))
+	require.Equal(amount, parsed)
+
+	// Unquoted integers are also accepted
+	require.NoError(json.Unmarshal([]byte(`2000000000`), &parsed))
+	require.Equal(AvaxAmount(2*Avax), parsed)
+
+	err = json.Unmarshal([]byte(`"1.5"`), &parsed)
+	require.ErrorIs(err, strconv.ErrSyntax)
+}
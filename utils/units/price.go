@@ -0,0 +1,82 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package units
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+)
+
+var (
+	// ErrOverflow and ErrUnderflow are also exported by utils/math, which
+	// depends on this package.
+	ErrOverflow       = errors.New("overflow")
+	ErrUnderflow      = errors.New("underflow")
+	ErrInvalidFactor  = errors.New("invalid factor")
+	ErrDivisionByZero = errors.New("division by zero")
+)
+
+// AvaxPrice is a fee denominated in nAVAX. Arithmetic on an AvaxPrice reports
+// overflow and underflow rather than wrapping.
+type AvaxPrice uint64
+
+// Add returns p + o.
+//
+// If overflow occurs, an error is returned.
+func (p AvaxPrice) Add(o AvaxPrice) (AvaxPrice, error) {
+	sum, carry := bits.Add64(uint64(p), uint64(o), 0)
+	if carry != 0 {
+		return 0, ErrOverflow
+	}
+	return AvaxPrice(sum), nil
+}
+
+// Sub returns p - o.
+//
+// If underflow occurs, an error is returned.
+func (p AvaxPrice) Sub(o AvaxPrice) (AvaxPrice, error) {
+	diff, borrow := bits.Sub64(uint64(p), uint64(o), 0)
+	if borrow != 0 {
+		return 0, ErrUnderflow
+	}
+	return AvaxPrice(diff), nil
+}
+
+// Mul returns p * factor, rounded to the nearest nAVAX. Halfway cases are
+// rounded away from zero.
+//
+// Because the multiplication is performed with float64 precision, prices
+// larger than 2^53 nAVAX may not be multiplied exactly.
+//
+// If factor is negative or NaN, an error is returned. If overflow occurs, an
+// error is returned.
+func (p AvaxPrice) Mul(factor float64) (AvaxPrice, error) {
+	if factor < 0 || math.IsNaN(factor) {
+		return 0, ErrInvalidFactor
+	}
+
+	v := math.Round(float64(p) * factor)
+	// float64(math.MaxUint64) rounds up to 2^64, so any value at least that
+	// large doesn't fit into a uint64.
+	if v >= float64(math.MaxUint64) {
+		return 0, ErrOverflow
+	}
+	return AvaxPrice(v), nil
+}
+
+// Div returns p / divisor, rounded down to the nearest nAVAX.
+//
+// If divisor is zero, an error is returned.
+func (p AvaxPrice) Div(divisor uint64) (AvaxPrice, error) {
+	if divisor == 0 {
+		return 0, ErrDivisionByZero
+	}
+	return p / AvaxPrice(divisor), nil
+}
+
+// ToNanoAvax returns the price in nAVAX.
+func (p AvaxPrice) ToNanoAvax() uint64 {
+	return uint64(p)
+}
@@ -0,0 +1,191 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package units
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAvaxPriceAdd(t *testing.T) {
+	tests := []struct {
+		name        string
+		a           AvaxPrice
+		b           AvaxPrice
+		expected    AvaxPrice
+		expectedErr error
+	}{
+		{
+			name:     "no overflow",
+			a:        AvaxPrice(Avax),
+			b:        AvaxPrice(MilliAvax),
+			expected: AvaxPrice(Avax + MilliAvax),
+		},
+		{
+			name:     "max",
+			a:        math.MaxUint64 - 1,
+			b:        1,
+			expected: math.MaxUint64,
+		},
+		{
+			name:        "overflow",
+			a:           math.MaxUint64,
+			b:           1,
+			expectedErr: ErrOverflow,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			actual, err := test.a.Add(test.b)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expected, actual)
+		})
+	}
+}
+
+func TestAvaxPriceSub(t *testing.T) {
+	tests := []struct {
+		name        string
+		a           AvaxPrice
+		b           AvaxPrice
+		expected    AvaxPrice
+		expectedErr error
+	}{
+		{
+			name:     "no underflow",
+			a:        AvaxPrice(Avax),
+			b:        AvaxPrice(MilliAvax),
+			expected: AvaxPrice(Avax - MilliAvax),
+		},
+		{
+			name:     "zero",
+			a:        1,
+			b:        1,
+			expected: 0,
+		},
+		{
+			name:        "underflow",
+			a:           0,
+			b:           1,
+			expectedErr: ErrUnderflow,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			actual, err := test.a.Sub(test.b)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expected, actual)
+		})
+	}
+}
+
+func TestAvaxPriceMul(t *testing.T) {
+	tests := []struct {
+		name        string
+		price       AvaxPrice
+		factor      float64
+		expected    AvaxPrice
+		expectedErr error
+	}{
+		{
+			name:     "integer factor",
+			price:    AvaxPrice(MilliAvax),
+			factor:   3,
+			expected: AvaxPrice(3 * MilliAvax),
+		},
+		{
+			name:     "fractional factor",
+			price:    AvaxPrice(Avax),
+			factor:   1.5,
+			expected: AvaxPrice(Avax + 500*MilliAvax),
+		},
+		{
+			name:     "round half away from zero",
+			price:    5,
+			factor:   0.5,
+			expected: 3,
+		},
+		{
+			name:     "zero factor",
+			price:    AvaxPrice(Avax),
+			factor:   0,
+			expected: 0,
+		},
+		{
+			name:        "negative factor",
+			price:       AvaxPrice(Avax),
+			factor:      -1,
+			expectedErr: ErrInvalidFactor,
+		},
+		{
+			name:        "NaN factor",
+			price:       AvaxPrice(Avax),
+			factor:      math.NaN(),
+			expectedErr: ErrInvalidFactor,
+		},
+		{
+			name:        "overflow",
+			price:       math.MaxUint64,
+			factor:      2,
+			expectedErr: ErrOverflow,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			actual, err := test.price.Mul(test.factor)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expected, actual)
+		})
+	}
+}
+
+func TestAvaxPriceDiv(t *testing.T) {
+	tests := []struct {
+		name        string
+		price       AvaxPrice
+		divisor     uint64
+		expected    AvaxPrice
+		expectedErr error
+	}{
+		{
+			name:     "exact",
+			price:    AvaxPrice(Avax),
+			divisor:  1000,
+			expected: AvaxPrice(MilliAvax),
+		},
+		{
+			name:     "rounds down",
+			price:    5,
+			divisor:  2,
+			expected: 2,
+		},
+		{
+			name:        "division by zero",
+			price:       AvaxPrice(Avax),
+			divisor:     0,
+			expectedErr: ErrDivisionByZero,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			actual, err := test.price.Div(test.divisor)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expected, actual)
+		})
+	}
+}
+
+func TestAvaxPriceToNanoAvax(t *testing.T) {
+	require.Equal(t, 2*Avax, AvaxPrice(2*Avax).ToNanoAvax())
+}
@@ -0,0 +1,36 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package units
+
+import "math/big"
+
+// WeiPerNanoAvax is the number of wei, the smallest denomination of AVAX on the
+// C-Chain, in 1 nAVAX, the smallest denomination of AVAX on the X-Chain and
+// P-Chain. AVAX has 9 decimal places on the X-Chain and P-Chain, but 18 decimal
+// places within the EVM, so 1 nAVAX = 1 gwei.
+const WeiPerNanoAvax = 1_000_000_000
+
+var weiPerNanoAvax = big.NewInt(WeiPerNanoAvax)
+
+// NanoAvaxToWei returns [nAVAX] denominated in wei.
+func NanoAvaxToWei(nAVAX uint64) *big.Int {
+	wei := new(big.Int).SetUint64(nAVAX)
+	return wei.Mul(wei, weiPerNanoAvax)
+}
+
+// WeiToNanoAvax returns [wei] denominated in nAVAX, rounded down to the nearest
+// nAVAX.
+//
+// If [wei] is negative, an error is returned. If the result doesn't fit into a
+// uint64, an error is returned.
+func WeiToNanoAvax(wei *big.Int) (uint64, error) {
+	if wei.Sign() < 0 {
+		return 0, ErrUnderflow
+	}
+	nAVAX := new(big.Int).Div(wei, weiPerNanoAvax)
+	if !nAVAX.IsUint64() {
+		return 0, ErrOverflow
+	}
+	return nAVAX.Uint64(), nil
+}
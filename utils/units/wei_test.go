@@ -0,0 +1,83 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package units
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNanoAvaxToWei(t *testing.T) {
+	require := require.New(t)
+
+	// 1 AVAX is 10^18 wei
+	expected, ok := new(big.Int).SetString("1000000000000000000", 10)
+	require.True(ok)
+	require.Equal(expected, NanoAvaxToWei(Avax))
+
+	require.Equal(big.NewInt(WeiPerNanoAvax), NanoAvaxToWei(NanoAvax))
+	require.Zero(NanoAvaxToWei(0).Sign())
+
+	// The result does not overflow
+	expected = new(big.Int).Mul(new(big.Int).SetUint64(math.MaxUint64), big.NewInt(WeiPerNanoAvax))
+	require.Equal(expected, NanoAvaxToWei(math.MaxUint64))
+}
+
+func TestWeiToNanoAvax(t *testing.T) {
+	maxNanoAvaxInWei := NanoAvaxToWei(math.MaxUint64)
+	tests := []struct {
+		name        string
+		wei         *big.Int
+		expected    uint64
+		expectedErr error
+	}{
+		{
+			name:     "zero",
+			wei:      big.NewInt(0),
+			expected: 0,
+		},
+		{
+			name:     "1 AVAX",
+			wei:      NanoAvaxToWei(Avax),
+			expected: Avax,
+		},
+		{
+			name:     "1 nAVAX",
+			wei:      big.NewInt(WeiPerNanoAvax),
+			expected: NanoAvax,
+		},
+		{
+			name:     "rounds down",
+			wei:      big.NewInt(2*WeiPerNanoAvax - 1),
+			expected: NanoAvax,
+		},
+		{
+			name:     "max",
+			wei:      maxNanoAvaxInWei,
+			expected: math.MaxUint64,
+		},
+		{
+			name:        "overflow",
+			wei:         new(big.Int).Add(maxNanoAvaxInWei, big.NewInt(WeiPerNanoAvax)),
+			expectedErr: ErrOverflow,
+		},
+		{
+			name:        "negative",
+			wei:         big.NewInt(-1),
+			expectedErr: ErrUnderflow,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			actual, err := WeiToNanoAvax(test.wei)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expected, actual)
+		})
+	}
+}
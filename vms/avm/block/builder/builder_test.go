@@ -41,7 +41,10 @@ import (
 	txexecutor "github.com/ava-labs/avalanchego/vms/avm/txs/executor"
 )
 
-const trackChecksums = false
+const (
+	trackChecksums = false
+	indexAssetTxs  = false
+)
 
 var (
 	errTest = errors.New("test error")
@@ -515,7 +518,7 @@ func TestBlockBuilderAddLocalTx(t *testing.T) {
 
 	baseDB := versiondb.New(memdb.New())
 
-	state, err := state.New(baseDB, parser, registerer, trackChecksums)
+	state, err := state.New(logging.NoLog{}, baseDB, parser, registerer, trackChecksums, indexAssetTxs)
 	require.NoError(err)
 
 	clk := &mockable.Clock{}
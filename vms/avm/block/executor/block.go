@@ -48,7 +48,7 @@ func (b *Block) Verify(context.Context) error {
 
 	// Currently we don't populate the blocks merkle root.
 	merkleRoot := b.Block.MerkleRoot()
-	if merkleRoot != ids.Empty {
+	if !merkleRoot.IsZero() {
 		return fmt.Errorf("%w: %s", ErrUnexpectedMerkleRoot, merkleRoot)
 	}
 
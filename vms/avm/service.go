@@ -295,7 +295,7 @@ func (s *Service) GetTxStatus(_ *http.Request, args *api.JSONTxID, reply *GetTxS
 		zap.Stringer("txID", args.TxID),
 	)
 
-	if args.TxID == ids.Empty {
+	if args.TxID.IsZero() {
 		return errNilTxID
 	}
 
@@ -322,7 +322,7 @@ func (s *Service) GetTx(_ *http.Request, args *api.GetTxArgs, reply *api.GetTxRe
 		zap.Stringer("txID", args.TxID),
 	)
 
-	if args.TxID == ids.Empty {
+	if args.TxID.IsZero() {
 		return errNilTxID
 	}
 
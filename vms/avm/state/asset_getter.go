@@ -0,0 +1,64 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/avm/txs"
+)
+
+var _ txs.Visitor = (*assetGetter)(nil)
+
+// assetGetter returns the assets a transaction involves.
+type assetGetter struct {
+	txID   ids.ID
+	assets set.Set[ids.ID]
+}
+
+// assetIDs returns the IDs of the assets consumed, produced, or operated on by
+// [tx]. A CreateAssetTx also involves the asset it creates.
+func assetIDs(tx *txs.Tx) set.Set[ids.ID] {
+	a := assetGetter{txID: tx.ID()}
+	// The visit error is explicitly dropped here because no error is ever
+	// returned from the assetGetter.
+	_ = tx.Unsigned.Visit(&a)
+	return a.assets
+}
+
+func (a *assetGetter) BaseTx(tx *txs.BaseTx) error {
+	for _, in := range tx.Ins {
+		a.assets.Add(in.AssetID())
+	}
+	for _, out := range tx.Outs {
+		a.assets.Add(out.AssetID())
+	}
+	return nil
+}
+
+func (a *assetGetter) CreateAssetTx(tx *txs.CreateAssetTx) error {
+	a.assets.Add(a.txID)
+	return a.BaseTx(&tx.BaseTx)
+}
+
+func (a *assetGetter) OperationTx(tx *txs.OperationTx) error {
+	for _, op := range tx.Ops {
+		a.assets.Add(op.AssetID())
+	}
+	return a.BaseTx(&tx.BaseTx)
+}
+
+func (a *assetGetter) ImportTx(tx *txs.ImportTx) error {
+	for _, in := range tx.ImportedIns {
+		a.assets.Add(in.AssetID())
+	}
+	return a.BaseTx(&tx.BaseTx)
+}
+
+func (a *assetGetter) ExportTx(tx *txs.ExportTx) error {
+	for _, out := range tx.ExportedOuts {
+		a.assets.Add(out.AssetID())
+	}
+	return a.BaseTx(&tx.BaseTx)
+}
@@ -6,9 +6,12 @@ package state
 import (
 	"errors"
 	"fmt"
+	"math"
+	"slices"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
 
 	"github.com/ava-labs/avalanchego/cache"
 	"github.com/ava-labs/avalanchego/cache/metercacher"
@@ -16,6 +19,8 @@ import (
 	"github.com/ava-labs/avalanchego/database/prefixdb"
 	"github.com/ava-labs/avalanchego/database/versiondb"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/timer"
 	"github.com/ava-labs/avalanchego/vms/avm/block"
 	"github.com/ava-labs/avalanchego/vms/avm/txs"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
@@ -25,11 +30,19 @@ const (
 	txCacheSize      = 8192
 	blockIDCacheSize = 8192
 	blockCacheSize   = 2048
+
+	assetTxIndexCommitPeriod = 1024
+	assetTxIndexLogPeriod    = 5 * time.Second
+
+	// MaxBlockRange is the maximum number of blocks that can be fetched by
+	// GetBlocksByRange.
+	MaxBlockRange = 1000
 )
 
 var (
 	utxoPrefix      = []byte("utxo")
 	txPrefix        = []byte("tx")
+	assetTxPrefix   = []byte("assetTx")
 	blockIDPrefix   = []byte("blockID")
 	blockPrefix     = []byte("block")
 	singletonPrefix = []byte("singleton")
@@ -37,8 +50,20 @@ var (
 	isInitializedKey = []byte{0x00}
 	timestampKey     = []byte{0x01}
 	lastAcceptedKey  = []byte{0x02}
+	// assetTxIndexCompleteKey is present if every committed tx is indexed by
+	// asset.
+	assetTxIndexCompleteKey = []byte{0x03}
+
+	_ State    = (*state)(nil)
+	_ Reverter = (*state)(nil)
+
+	ErrInvalidBlockRange  = errors.New("end height is less than start height")
+	ErrBlockRangeTooLarge = errors.New("block range is too large")
+	ErrCantRevert         = errors.New("can't revert to a height at or above the last accepted block")
 
-	_ State = (*state)(nil)
+	ErrAssetTxIndexDisabled = errors.New("asset tx index is disabled")
+
+	errMissingProducedUTXO = errors.New("producing tx is missing the consumed utxo")
 )
 
 type ReadOnlyChain interface {
@@ -68,6 +93,25 @@ type State interface {
 	Chain
 	avax.UTXOReader
 
+	// BatchGetUTXOs returns the UTXOs with the provided IDs. The returned
+	// slices have one entry per ID. If a UTXO couldn't be fetched, its entry
+	// is nil and the corresponding error is set.
+	BatchGetUTXOs(utxoIDs []ids.ID) ([]*avax.UTXO, []error)
+
+	// GetTxsByAsset returns up to [limit] committed txs that involve
+	// [assetID], ordered by txID. Only txs with IDs greater than [previous]
+	// are returned, so the ID of the last tx of a page can be provided to
+	// fetch the next page.
+	//
+	// If the asset tx index is disabled, [ErrAssetTxIndexDisabled] is
+	// returned.
+	GetTxsByAsset(assetID ids.ID, previous ids.ID, limit int) ([]*txs.Tx, error)
+
+	// GetBlocksByRange returns the blocks with heights in
+	// [startHeight, endHeight]. If there is no block at a height, its entry
+	// is nil. At most [MaxBlockRange] blocks can be requested.
+	GetBlocksByRange(startHeight, endHeight uint64) ([]block.Block, error)
+
 	IsInitialized() (bool, error)
 	SetInitialized() error
 
@@ -95,12 +139,26 @@ type State interface {
 	Close() error
 }
 
+// Reverter is implemented by the State returned by New. It isn't part of State
+// because accepted blocks must never be reverted outside of tests.
+type Reverter interface {
+	// RevertToHeight rolls back the blocks above [height] by restoring the
+	// UTXOs they consumed, removing the UTXOs they produced, removing their
+	// height index entries, and setting the last accepted block to the block
+	// at [height]. The rolled back blocks and txs remain stored, and atomic
+	// operations are not reverted.
+	RevertToHeight(height uint64) error
+}
+
 /*
  * VMDB
  * |- utxos
  * | '-- utxoDB
  * |-. txs
  * | '-- txID -> tx bytes
+ * |-. assetTxs
+ * | '-. assetID
+ * |   '-- txID -> nil
  * |-. blockIDs
  * | '-- height -> blockID
  * |-. blocks
@@ -108,9 +166,11 @@ type State interface {
  * '-. singletons
  *   |-- initializedKey -> nil
  *   |-- timestampKey -> timestamp
- *   '-- lastAcceptedKey -> lastAccepted
+ *   |-- lastAcceptedKey -> lastAccepted
+ *   '-- assetTxIndexCompleteKey -> nil
  */
 type state struct {
+	log    logging.Logger
 	parser block.Parser
 	db     *versiondb.Database
 
@@ -122,7 +182,10 @@ type state struct {
 	txCache  cache.Cacher[ids.ID, *txs.Tx] // cache of txID -> *txs.Tx. If the entry is nil, it is not in the database
 	txDB     database.Database
 
-	addedBlockIDs map[uint64]ids.ID            // map of height -> blockID
+	indexAssetTxs bool
+	assetTxDB     database.Database
+
+	addedBlockIDs map[uint64]ids.ID            // map of height -> blockID. If the entry is ids.Empty, it has been removed
 	blockIDCache  cache.Cacher[uint64, ids.ID] // cache of height -> blockID. If the entry is ids.Empty, it is not in the database
 	blockIDDB     database.Database
 
@@ -137,13 +200,16 @@ type state struct {
 }
 
 func New(
+	log logging.Logger,
 	db *versiondb.Database,
 	parser block.Parser,
 	metrics prometheus.Registerer,
 	trackChecksums bool,
+	indexAssetTxs bool,
 ) (State, error) {
 	utxoDB := prefixdb.New(utxoPrefix, db)
 	txDB := prefixdb.New(txPrefix, db)
+	assetTxDB := prefixdb.New(assetTxPrefix, db)
 	blockIDDB := prefixdb.New(blockIDPrefix, db)
 	blockDB := prefixdb.New(blockPrefix, db)
 	singletonDB := prefixdb.New(singletonPrefix, db)
@@ -180,7 +246,8 @@ func New(
 		return nil, err
 	}
 
-	return &state{
+	s := &state{
+		log:    log,
 		parser: parser,
 		db:     db,
 
@@ -192,6 +259,9 @@ func New(
 		txCache:  txCache,
 		txDB:     txDB,
 
+		indexAssetTxs: indexAssetTxs,
+		assetTxDB:     assetTxDB,
+
 		addedBlockIDs: make(map[uint64]ids.ID),
 		blockIDCache:  blockIDCache,
 		blockIDDB:     blockIDDB,
@@ -201,7 +271,117 @@ func New(
 		blockDB:     blockDB,
 
 		singletonDB: singletonDB,
-	}, nil
+	}
+	if err := s.initAssetTxIndex(); err != nil {
+		return nil, fmt.Errorf("failed to initialize asset tx index: %w", err)
+	}
+	return s, nil
+}
+
+// initAssetTxIndex ensures that every committed tx is indexed by asset if the
+// asset tx index is enabled. Txs committed while the index was disabled are
+// indexed by iterating over all the committed txs. The index is committed
+// periodically while it is being built to bound memory usage. If the build is
+// interrupted, it is restarted from the beginning on the next startup.
+func (s *state) initAssetTxIndex() error {
+	complete, err := s.singletonDB.Has(assetTxIndexCompleteKey)
+	if err != nil {
+		return err
+	}
+	if !s.indexAssetTxs {
+		if !complete {
+			return nil
+		}
+		// Txs committed from now on won't be indexed, so the index must be
+		// rebuilt if it is re-enabled.
+		if err := s.singletonDB.Delete(assetTxIndexCompleteKey); err != nil {
+			return err
+		}
+		return s.db.Commit()
+	}
+	if complete {
+		return nil
+	}
+
+	var (
+		iter               = s.txDB.NewIterator()
+		numIndexed         uint64
+		indexedSinceCommit int
+		startTime          = time.Now()
+		timeOfNextLog      = startTime.Add(assetTxIndexLogPeriod)
+	)
+	defer func() {
+		iter.Release()
+	}()
+
+	s.log.Info("indexing txs by asset")
+	for iter.Next() {
+		tx, err := s.parser.ParseGenesisTx(iter.Value())
+		if err != nil {
+			return fmt.Errorf("failed to parse tx: %w", err)
+		}
+		if err := s.indexAssetTx(tx); err != nil {
+			return err
+		}
+		numIndexed++
+
+		if now := time.Now(); now.After(timeOfNextLog) {
+			var (
+				progress = timer.ProgressFromHash(iter.Key())
+				eta      = timer.EstimateETA(startTime, progress, math.MaxUint64)
+			)
+			s.log.Info("indexing txs by asset",
+				zap.Uint64("numIndexed", numIndexed),
+				zap.Duration("eta", eta),
+			)
+			timeOfNextLog = now.Add(assetTxIndexLogPeriod)
+		}
+
+		indexedSinceCommit++
+		if indexedSinceCommit < assetTxIndexCommitPeriod {
+			continue
+		}
+		if err := iter.Error(); err != nil {
+			return fmt.Errorf("failed to iterate over txs: %w", err)
+		}
+		if err := s.db.Commit(); err != nil {
+			return err
+		}
+		indexedSinceCommit = 0
+
+		// Release and re-grab the iterator to avoid keeping a reference to an
+		// old database revision. Appending a zero byte to the last indexed
+		// key results in the smallest key after it.
+		nextKey := append(slices.Clone(iter.Key()), 0x00)
+		iter.Release()
+		iter = s.txDB.NewIteratorWithStart(nextKey)
+	}
+	if err := iter.Error(); err != nil {
+		return fmt.Errorf("failed to iterate over txs: %w", err)
+	}
+	if err := s.singletonDB.Put(assetTxIndexCompleteKey, nil); err != nil {
+		return err
+	}
+	if err := s.db.Commit(); err != nil {
+		return err
+	}
+
+	s.log.Info("indexed txs by asset",
+		zap.Uint64("numIndexed", numIndexed),
+		zap.Duration("duration", time.Since(startTime)),
+	)
+	return nil
+}
+
+func (s *state) indexAssetTx(tx *txs.Tx) error {
+	txID := tx.ID()
+	for assetID := range assetIDs(tx) {
+		assetDB := prefixdb.New(assetID[:], s.assetTxDB)
+		if err := assetDB.Put(txID[:], nil); err != nil {
+			return fmt.Errorf("failed to index tx %s by asset: %w", txID, err)
+		}
+	}
+	return nil
 }
 
 func (s *state) GetUTXO(utxoID ids.ID) (*avax.UTXO, error) {
@@ -214,6 +394,19 @@ func (s *state) GetUTXO(utxoID ids.ID) (*avax.UTXO, error) {
 	return s.utxoState.GetUTXO(utxoID)
 }
 
+// BatchGetUTXOs reads the UTXOs sequentially, as the underlying database
+// doesn't support batched reads.
+func (s *state) BatchGetUTXOs(utxoIDs []ids.ID) ([]*avax.UTXO, []error) {
+	var (
+		utxos = make([]*avax.UTXO, len(utxoIDs))
+		errs  = make([]error, len(utxoIDs))
+	)
+	for i, utxoID := range utxoIDs {
+		utxos[i], errs[i] = s.GetUTXO(utxoID)
+	}
+	return utxos, errs
+}
+
 func (s *state) UTXOIDs(addr []byte, start ids.ID, limit int) ([]ids.ID, error) {
 	return s.utxoState.UTXOIDs(addr, start, limit)
 }
@@ -261,12 +454,54 @@ func (s *state) AddTx(tx *txs.Tx) {
 	s.addedTxs[txID] = tx
 }
 
+func (s *state) GetTxsByAsset(assetID ids.ID, previous ids.ID, limit int) ([]*txs.Tx, error) {
+	if !s.indexAssetTxs {
+		return nil, ErrAssetTxIndexDisabled
+	}
+
+	txIDs, err := s.assetTxIDs(assetID, previous, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	assetTxs := make([]*txs.Tx, len(txIDs))
+	for i, txID := range txIDs {
+		assetTxs[i], err = s.GetTx(txID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tx %s of asset %s: %w", txID, assetID, err)
+		}
+	}
+	return assetTxs, nil
+}
+
+func (s *state) assetTxIDs(assetID ids.ID, previous ids.ID, limit int) ([]ids.ID, error) {
+	assetDB := prefixdb.New(assetID[:], s.assetTxDB)
+	iter := assetDB.NewIteratorWithStart(previous[:])
+	defer iter.Release()
+
+	txIDs := []ids.ID(nil)
+	for len(txIDs) < limit && iter.Next() {
+		txID, err := ids.ToID(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		if txID == previous {
+			continue
+		}
+		txIDs = append(txIDs, txID)
+	}
+	return txIDs, iter.Error()
+}
+
 func (s *state) GetBlockIDAtHeight(height uint64) (ids.ID, error) {
 	if blkID, exists := s.addedBlockIDs[height]; exists {
+		if blkID.IsZero() {
+			return ids.Empty, database.ErrNotFound
+		}
 		return blkID, nil
 	}
 	if blkID, cached := s.blockIDCache.Get(height); cached {
-		if blkID == ids.Empty {
+		if blkID.IsZero() {
 			return ids.Empty, database.ErrNotFound
 		}
 
@@ -318,6 +553,108 @@ func (s *state) GetBlock(blkID ids.ID) (block.Block, error) {
 	return blk, nil
 }
 
+func (s *state) GetBlocksByRange(startHeight, endHeight uint64) ([]block.Block, error) {
+	if endHeight < startHeight {
+		return nil, fmt.Errorf("%w: [%d, %d]", ErrInvalidBlockRange, startHeight, endHeight)
+	}
+	if numBlocks := endHeight - startHeight + 1; numBlocks == 0 || numBlocks > MaxBlockRange {
+		return nil, fmt.Errorf("%w: [%d, %d] exceeds %d blocks", ErrBlockRangeTooLarge, startHeight, endHeight, MaxBlockRange)
+	}
+
+	blks := make([]block.Block, endHeight-startHeight+1)
+	for i := range blks {
+		height := startHeight + uint64(i)
+		blkID, err := s.GetBlockIDAtHeight(height)
+		if err == database.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get blockID at height %d: %w", height, err)
+		}
+
+		blk, err := s.GetBlock(blkID)
+		if err == database.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get block %s: %w", blkID, err)
+		}
+		blks[i] = blk
+	}
+	return blks, nil
+}
+
+func (s *state) RevertToHeight(height uint64) error {
+	lastAccepted, err := s.GetBlock(s.lastAccepted)
+	if err != nil {
+		return fmt.Errorf("failed to get last accepted block %s: %w", s.lastAccepted, err)
+	}
+	lastAcceptedHeight := lastAccepted.Height()
+	if lastAcceptedHeight <= height {
+		return fmt.Errorf("%w: %d >= %d", ErrCantRevert, height, lastAcceptedHeight)
+	}
+
+	targetID, err := s.GetBlockIDAtHeight(height)
+	if err != nil {
+		return fmt.Errorf("failed to get blockID at height %d: %w", height, err)
+	}
+	target, err := s.GetBlock(targetID)
+	if err != nil {
+		return fmt.Errorf("failed to get block %s: %w", targetID, err)
+	}
+
+	for h := lastAcceptedHeight; h > height; h-- {
+		blkID, err := s.GetBlockIDAtHeight(h)
+		if err != nil {
+			return fmt.Errorf("failed to get blockID at height %d: %w", h, err)
+		}
+		blk, err := s.GetBlock(blkID)
+		if err != nil {
+			return fmt.Errorf("failed to get block %s: %w", blkID, err)
+		}
+
+		// Txs are reverted in the opposite order that they were executed so
+		// that UTXOs produced and consumed within the block are handled
+		// correctly.
+		blkTxs := blk.Txs()
+		for i := len(blkTxs) - 1; i >= 0; i-- {
+			if err := s.revertTx(blkTxs[i]); err != nil {
+				return fmt.Errorf("failed to revert tx %s of block %s: %w", blkTxs[i].ID(), blkID, err)
+			}
+		}
+		s.addedBlockIDs[h] = ids.Empty
+	}
+
+	s.SetLastAccepted(targetID)
+	s.SetTimestamp(target.Timestamp())
+	return nil
+}
+
+func (s *state) revertTx(tx *txs.Tx) error {
+	for _, utxo := range tx.UTXOs() {
+		s.DeleteUTXO(utxo.InputID())
+	}
+
+	for _, utxoID := range tx.Unsigned.InputUTXOs() {
+		// Imported UTXOs are consumed from shared memory rather than the
+		// UTXO set.
+		if utxoID.Symbol {
+			continue
+		}
+
+		producingTx, err := s.GetTx(utxoID.TxID)
+		if err != nil {
+			return fmt.Errorf("failed to get tx %s: %w", utxoID.TxID, err)
+		}
+		producedUTXOs := producingTx.UTXOs()
+		if int(utxoID.OutputIndex) >= len(producedUTXOs) {
+			return fmt.Errorf("%w: %s", errMissingProducedUTXO, utxoID)
+		}
+		s.AddUTXO(producedUTXOs[utxoID.OutputIndex])
+	}
+	return nil
+}
+
 func (s *state) AddBlock(block block.Block) {
 	blkID := block.ID()
 	s.addedBlockIDs[block.Height()] = blkID
@@ -416,6 +753,7 @@ func (s *state) Close() error {
 	return errors.Join(
 		s.utxoDB.Close(),
 		s.txDB.Close(),
+		s.assetTxDB.Close(),
 		s.blockIDDB.Close(),
 		s.blockDB.Close(),
 		s.singletonDB.Close(),
@@ -459,6 +797,11 @@ func (s *state) writeTxs() error {
 		if err := s.txDB.Put(txID[:], txBytes); err != nil {
 			return fmt.Errorf("failed to add tx: %w", err)
 		}
+		if s.indexAssetTxs {
+			if err := s.indexAssetTx(tx); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
@@ -469,6 +812,12 @@ func (s *state) writeBlockIDs() error {
 
 		delete(s.addedBlockIDs, height)
 		s.blockIDCache.Put(height, blkID)
+		if blkID.IsZero() {
+			if err := s.blockIDDB.Delete(heightKey); err != nil {
+				return fmt.Errorf("failed to remove blockID: %w", err)
+			}
+			continue
+		}
 		if err := database.PutID(s.blockIDDB, heightKey, blkID); err != nil {
 			return fmt.Errorf("failed to add blockID: %w", err)
 		}
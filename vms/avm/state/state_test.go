@@ -4,6 +4,7 @@
 package state
 
 import (
+	"math"
 	"testing"
 	"time"
 
@@ -15,6 +16,8 @@ import (
 	"github.com/ava-labs/avalanchego/database/versiondb"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/upgrade"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/vms/avm/block"
 	"github.com/ava-labs/avalanchego/vms/avm/fxs"
 	"github.com/ava-labs/avalanchego/vms/avm/txs"
@@ -22,7 +25,10 @@ import (
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 )
 
-const trackChecksums = false
+const (
+	trackChecksums = false
+	indexAssetTxs  = true
+)
 
 var (
 	parser             block.Parser
@@ -102,7 +108,7 @@ func TestState(t *testing.T) {
 
 	db := memdb.New()
 	vdb := versiondb.New(db)
-	s, err := New(vdb, parser, prometheus.NewRegistry(), trackChecksums)
+	s, err := New(logging.NoLog{}, vdb, parser, prometheus.NewRegistry(), trackChecksums, indexAssetTxs)
 	require.NoError(err)
 
 	s.AddUTXO(populatedUTXO)
@@ -110,7 +116,7 @@ func TestState(t *testing.T) {
 	s.AddBlock(populatedBlk)
 	require.NoError(s.Commit())
 
-	s, err = New(vdb, parser, prometheus.NewRegistry(), trackChecksums)
+	s, err = New(logging.NoLog{}, vdb, parser, prometheus.NewRegistry(), trackChecksums, indexAssetTxs)
 	require.NoError(err)
 
 	ChainUTXOTest(t, s)
@@ -123,7 +129,7 @@ func TestDiff(t *testing.T) {
 
 	db := memdb.New()
 	vdb := versiondb.New(db)
-	s, err := New(vdb, parser, prometheus.NewRegistry(), trackChecksums)
+	s, err := New(logging.NoLog{}, vdb, parser, prometheus.NewRegistry(), trackChecksums, indexAssetTxs)
 	require.NoError(err)
 
 	s.AddUTXO(populatedUTXO)
@@ -283,7 +289,7 @@ func TestInitializeChainState(t *testing.T) {
 
 	db := memdb.New()
 	vdb := versiondb.New(db)
-	s, err := New(vdb, parser, prometheus.NewRegistry(), trackChecksums)
+	s, err := New(logging.NoLog{}, vdb, parser, prometheus.NewRegistry(), trackChecksums, indexAssetTxs)
 	require.NoError(err)
 
 	stopVertexID := ids.GenerateTestID()
@@ -316,3 +322,429 @@ func TestInitializeChainState(t *testing.T) {
 	require.NoError(err)
 	require.Equal(genesis.ID(), lastAccepted.Parent())
 }
+
+func TestBatchGetUTXOs(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	vdb := versiondb.New(db)
+	s, err := New(logging.NoLog{}, vdb, parser, prometheus.NewRegistry(), trackChecksums, indexAssetTxs)
+	require.NoError(err)
+
+	s.AddUTXO(populatedUTXO)
+	require.NoError(s.Commit())
+
+	pendingUTXO := &avax.UTXO{
+		UTXOID: avax.UTXOID{
+			TxID: ids.GenerateTestID(),
+		},
+		Asset: avax.Asset{
+			ID: ids.GenerateTestID(),
+		},
+		Out: &secp256k1fx.TransferOutput{
+			Amt: 1,
+		},
+	}
+	s.AddUTXO(pendingUTXO)
+
+	missingUTXOID := ids.GenerateTestID()
+	utxos, errs := s.BatchGetUTXOs([]ids.ID{
+		populatedUTXOID,
+		missingUTXOID,
+		pendingUTXO.InputID(),
+	})
+	require.Len(utxos, 3)
+	require.Len(errs, 3)
+
+	require.NoError(errs[0])
+	// Compare IDs because the fetched UTXO isn't initialized
+	require.Equal(populatedUTXOID, utxos[0].InputID())
+
+	require.ErrorIs(errs[1], database.ErrNotFound)
+	require.Nil(utxos[1])
+
+	require.NoError(errs[2])
+	require.Equal(pendingUTXO, utxos[2])
+}
+
+func newAssetTx(t *testing.T, assetID ids.ID) *txs.Tx {
+	tx := &txs.Tx{Unsigned: &txs.BaseTx{BaseTx: avax.BaseTx{
+		BlockchainID: ids.GenerateTestID(),
+		Outs: []*avax.TransferableOutput{
+			{
+				Asset: avax.Asset{ID: assetID},
+				Out: &secp256k1fx.TransferOutput{
+					Amt: 1,
+				},
+			},
+		},
+	}}}
+	require.NoError(t, tx.Initialize(parser.Codec()))
+	return tx
+}
+
+func TestGetTxsByAsset(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	vdb := versiondb.New(db)
+	s, err := New(logging.NoLog{}, vdb, parser, prometheus.NewRegistry(), trackChecksums, indexAssetTxs)
+	require.NoError(err)
+
+	createAssetTx := &txs.Tx{Unsigned: &txs.CreateAssetTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			BlockchainID: ids.GenerateTestID(),
+		}},
+		Name:   "asset",
+		Symbol: "A",
+	}}
+	require.NoError(createAssetTx.Initialize(parser.Codec()))
+	assetID := createAssetTx.ID()
+
+	var (
+		otherAssetID = ids.GenerateTestID()
+		assetTxs     = []*txs.Tx{
+			createAssetTx,
+			newAssetTx(t, assetID),
+			newAssetTx(t, assetID),
+			newAssetTx(t, assetID),
+		}
+		otherAssetTx = newAssetTx(t, otherAssetID)
+	)
+	for _, tx := range assetTxs {
+		s.AddTx(tx)
+	}
+	s.AddTx(otherAssetTx)
+
+	// Txs are only indexed once they are committed
+	fetchedTxs, err := s.GetTxsByAsset(assetID, ids.Empty, len(assetTxs))
+	require.NoError(err)
+	require.Empty(fetchedTxs)
+
+	require.NoError(s.Commit())
+
+	fetchedTxs, err = s.GetTxsByAsset(otherAssetID, ids.Empty, len(assetTxs))
+	require.NoError(err)
+	require.Equal([]*txs.Tx{otherAssetTx}, fetchedTxs)
+
+	fetchedTxs, err = s.GetTxsByAsset(ids.GenerateTestID(), ids.Empty, len(assetTxs))
+	require.NoError(err)
+	require.Empty(fetchedTxs)
+
+	// Page through the txs of the asset
+	var (
+		pagedTxs []*txs.Tx
+		previous = ids.Empty
+	)
+	for {
+		page, err := s.GetTxsByAsset(assetID, previous, 3)
+		require.NoError(err)
+		if len(page) == 0 {
+			break
+		}
+		require.LessOrEqual(len(page), 3)
+
+		pagedTxs = append(pagedTxs, page...)
+		previous = page[len(page)-1].ID()
+	}
+	require.ElementsMatch(assetTxs, pagedTxs)
+
+	pagedTxIDs := make([]ids.ID, len(pagedTxs))
+	for i, tx := range pagedTxs {
+		pagedTxIDs[i] = tx.ID()
+	}
+	require.True(utils.IsSortedAndUnique(pagedTxIDs))
+}
+
+func TestAssetTxIndexBackfill(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		vdb     = versiondb.New(memdb.New())
+		assetID = ids.GenerateTestID()
+		tx0     = newAssetTx(t, assetID)
+		tx1     = newAssetTx(t, assetID)
+	)
+	s, err := New(logging.NoLog{}, vdb, parser, prometheus.NewRegistry(), trackChecksums, false)
+	require.NoError(err)
+
+	s.AddTx(tx0)
+	require.NoError(s.Commit())
+
+	_, err = s.GetTxsByAsset(assetID, ids.Empty, 2)
+	require.ErrorIs(err, ErrAssetTxIndexDisabled)
+
+	// Enabling the index indexes the txs committed while it was disabled
+	s, err = New(logging.NoLog{}, vdb, parser, prometheus.NewRegistry(), trackChecksums, true)
+	require.NoError(err)
+
+	fetchedTxs, err := s.GetTxsByAsset(assetID, ids.Empty, 2)
+	require.NoError(err)
+	require.Len(fetchedTxs, 1)
+	require.Equal(tx0.ID(), fetchedTxs[0].ID())
+
+	// Disabling the index again marks it as incomplete
+	s, err = New(logging.NoLog{}, vdb, parser, prometheus.NewRegistry(), trackChecksums, false)
+	require.NoError(err)
+
+	s.AddTx(tx1)
+	require.NoError(s.Commit())
+
+	s, err = New(logging.NoLog{}, vdb, parser, prometheus.NewRegistry(), trackChecksums, true)
+	require.NoError(err)
+
+	fetchedTxs, err = s.GetTxsByAsset(assetID, ids.Empty, 2)
+	require.NoError(err)
+	require.Len(fetchedTxs, 2)
+	require.ElementsMatch(
+		[]ids.ID{tx0.ID(), tx1.ID()},
+		[]ids.ID{fetchedTxs[0].ID(), fetchedTxs[1].ID()},
+	)
+}
+
+func TestAssetTxIndexBackfillMultipleCommits(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		vdb      = versiondb.New(memdb.New())
+		assetID  = ids.GenerateTestID()
+		numTxs   = 2*assetTxIndexCommitPeriod + 1
+		txIDs    = make([]ids.ID, numTxs)
+		pageSize = numTxs + 1
+	)
+	s, err := New(logging.NoLog{}, vdb, parser, prometheus.NewRegistry(), trackChecksums, false)
+	require.NoError(err)
+
+	for i := range txIDs {
+		tx := newAssetTx(t, assetID)
+		s.AddTx(tx)
+		txIDs[i] = tx.ID()
+	}
+	require.NoError(s.Commit())
+
+	s, err = New(logging.NoLog{}, vdb, parser, prometheus.NewRegistry(), trackChecksums, true)
+	require.NoError(err)
+
+	fetchedTxs, err := s.GetTxsByAsset(assetID, ids.Empty, pageSize)
+	require.NoError(err)
+
+	fetchedTxIDs := make([]ids.ID, len(fetchedTxs))
+	for i, tx := range fetchedTxs {
+		fetchedTxIDs[i] = tx.ID()
+	}
+	require.ElementsMatch(txIDs, fetchedTxIDs)
+}
+
+func TestGetBlocksByRange(t *testing.T) {
+	db := memdb.New()
+	vdb := versiondb.New(db)
+	s, err := New(logging.NoLog{}, vdb, parser, prometheus.NewRegistry(), trackChecksums, indexAssetTxs)
+	require.NoError(t, err)
+
+	// Populate heights 1, 2, and 4, leaving a gap at height 3
+	blks := make(map[uint64]block.Block)
+	for _, height := range []uint64{1, 2, 4} {
+		blk, err := block.NewStandardBlock(
+			ids.GenerateTestID(),
+			height,
+			upgrade.InitiallyActiveTime,
+			nil,
+			parser.Codec(),
+		)
+		require.NoError(t, err)
+
+		s.AddBlock(blk)
+		blks[height] = blk
+	}
+	require.NoError(t, s.Commit())
+
+	tests := []struct {
+		name           string
+		startHeight    uint64
+		endHeight      uint64
+		expectedBlocks []block.Block
+		expectedErr    error
+	}{
+		{
+			name:           "single block",
+			startHeight:    1,
+			endHeight:      1,
+			expectedBlocks: []block.Block{blks[1]},
+		},
+		{
+			name:           "empty range",
+			startHeight:    10,
+			endHeight:      11,
+			expectedBlocks: []block.Block{nil, nil},
+		},
+		{
+			name:           "mid-range gap",
+			startHeight:    1,
+			endHeight:      4,
+			expectedBlocks: []block.Block{blks[1], blks[2], nil, blks[4]},
+		},
+		{
+			name:        "end before start",
+			startHeight: 2,
+			endHeight:   1,
+			expectedErr: ErrInvalidBlockRange,
+		},
+		{
+			name:        "range too large",
+			startHeight: 0,
+			endHeight:   MaxBlockRange,
+			expectedErr: ErrBlockRangeTooLarge,
+		},
+		{
+			name:        "range overflows",
+			startHeight: 0,
+			endHeight:   math.MaxUint64,
+			expectedErr: ErrBlockRangeTooLarge,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			fetchedBlks, err := s.GetBlocksByRange(test.startHeight, test.endHeight)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expectedBlocks, fetchedBlks)
+		})
+	}
+
+	t.Run("max range", func(t *testing.T) {
+		require := require.New(t)
+
+		fetchedBlks, err := s.GetBlocksByRange(1, MaxBlockRange)
+		require.NoError(err)
+		require.Len(fetchedBlks, MaxBlockRange)
+		require.Equal([]block.Block{blks[1], blks[2], nil, blks[4]}, fetchedBlks[:4])
+		for _, blk := range fetchedBlks[4:] {
+			require.Nil(blk)
+		}
+	})
+}
+
+func TestRevertToHeight(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	vdb := versiondb.New(db)
+	s, err := New(logging.NoLog{}, vdb, parser, prometheus.NewRegistry(), trackChecksums, indexAssetTxs)
+	require.NoError(err)
+	require.NoError(s.InitializeChainState(ids.GenerateTestID(), upgrade.InitiallyActiveTime))
+	genesisID := s.GetLastAccepted()
+
+	assetID := ids.GenerateTestID()
+	newTx := func(consumed *avax.UTXO) *txs.Tx {
+		utx := &txs.BaseTx{BaseTx: avax.BaseTx{
+			BlockchainID: ids.GenerateTestID(),
+			Outs: []*avax.TransferableOutput{
+				{
+					Asset: avax.Asset{ID: assetID},
+					Out: &secp256k1fx.TransferOutput{
+						Amt: 1,
+					},
+				},
+			},
+		}}
+		if consumed != nil {
+			utx.Ins = []*avax.TransferableInput{
+				{
+					UTXOID: consumed.UTXOID,
+					Asset:  consumed.Asset,
+					In: &secp256k1fx.TransferInput{
+						Amt: 1,
+					},
+				},
+			}
+		}
+		tx := &txs.Tx{Unsigned: utx}
+		require.NoError(tx.Initialize(parser.Codec()))
+		return tx
+	}
+
+	// The initial UTXO is produced by a tx accepted before linearization
+	initialTx := newTx(nil)
+	s.AddTx(initialTx)
+	avax.Produce(s, initialTx.ID(), initialTx.Unsigned.(*txs.BaseTx).Outs)
+	require.NoError(s.Commit())
+	initialUTXO := initialTx.UTXOs()[0]
+
+	// Each block spends the UTXO produced by the previous block
+	var (
+		parentID   = genesisID
+		timestamps = []time.Time{upgrade.InitiallyActiveTime}
+		utxos      = []*avax.UTXO{initialUTXO}
+	)
+	for height := uint64(1); height <= 3; height++ {
+		tx := newTx(utxos[len(utxos)-1])
+		timestamp := upgrade.InitiallyActiveTime.Add(time.Duration(height) * time.Second)
+		blk, err := block.NewStandardBlock(
+			parentID,
+			height,
+			timestamp,
+			[]*txs.Tx{tx},
+			parser.Codec(),
+		)
+		require.NoError(err)
+
+		utx := tx.Unsigned.(*txs.BaseTx)
+		avax.Consume(s, utx.Ins)
+		avax.Produce(s, tx.ID(), utx.Outs)
+		s.AddTx(tx)
+		s.AddBlock(blk)
+		s.SetLastAccepted(blk.ID())
+		s.SetTimestamp(timestamp)
+		require.NoError(s.Commit())
+
+		parentID = blk.ID()
+		timestamps = append(timestamps, timestamp)
+		utxos = append(utxos, tx.UTXOs()[0])
+	}
+
+	requireUTXOs := func(expectedIndex int) {
+		for i, utxo := range utxos {
+			_, err := s.GetUTXO(utxo.InputID())
+			if i == expectedIndex {
+				require.NoError(err)
+			} else {
+				require.ErrorIs(err, database.ErrNotFound)
+			}
+		}
+	}
+	requireUTXOs(3)
+
+	reverter := s.(Reverter)
+	err = reverter.RevertToHeight(3)
+	require.ErrorIs(err, ErrCantRevert)
+
+	// Revert multiple blocks
+	require.NoError(reverter.RevertToHeight(1))
+	require.NoError(s.Commit())
+	requireUTXOs(1)
+	blkID, err := s.GetBlockIDAtHeight(1)
+	require.NoError(err)
+	require.Equal(blkID, s.GetLastAccepted())
+	require.Equal(timestamps[1].Unix(), s.GetTimestamp().Unix())
+
+	// The reverted blocks are no longer indexed by height, including after
+	// reloading the state
+	s, err = New(logging.NoLog{}, vdb, parser, prometheus.NewRegistry(), trackChecksums, indexAssetTxs)
+	require.NoError(err)
+	require.NoError(s.InitializeChainState(ids.GenerateTestID(), upgrade.InitiallyActiveTime))
+	require.Equal(blkID, s.GetLastAccepted())
+	for height := uint64(2); height <= 3; height++ {
+		_, err := s.GetBlockIDAtHeight(height)
+		require.ErrorIs(err, database.ErrNotFound)
+	}
+
+	// Revert to genesis
+	require.NoError(s.(Reverter).RevertToHeight(0))
+	require.NoError(s.Commit())
+	requireUTXOs(0)
+	require.Equal(genesisID, s.GetLastAccepted())
+	require.Equal(timestamps[0].Unix(), s.GetTimestamp().Unix())
+	_, err = s.GetBlockIDAtHeight(1)
+	require.ErrorIs(err, database.ErrNotFound)
+}
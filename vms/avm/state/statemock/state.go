@@ -93,6 +93,21 @@ func (mr *StateMockRecorder) AddUTXO(utxo any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUTXO", reflect.TypeOf((*State)(nil).AddUTXO), utxo)
 }
 
+// BatchGetUTXOs mocks base method.
+func (m *State) BatchGetUTXOs(utxoIDs []ids.ID) ([]*avax.UTXO, []error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetUTXOs", utxoIDs)
+	ret0, _ := ret[0].([]*avax.UTXO)
+	ret1, _ := ret[1].([]error)
+	return ret0, ret1
+}
+
+// BatchGetUTXOs indicates an expected call of BatchGetUTXOs.
+func (mr *StateMockRecorder) BatchGetUTXOs(utxoIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetUTXOs", reflect.TypeOf((*State)(nil).BatchGetUTXOs), utxoIDs)
+}
+
 // Checksum mocks base method.
 func (m *State) Checksum() ids.ID {
 	m.ctrl.T.Helper()
@@ -192,6 +207,21 @@ func (mr *StateMockRecorder) GetBlockIDAtHeight(height any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockIDAtHeight", reflect.TypeOf((*State)(nil).GetBlockIDAtHeight), height)
 }
 
+// GetBlocksByRange mocks base method.
+func (m *State) GetBlocksByRange(startHeight, endHeight uint64) ([]block.Block, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlocksByRange", startHeight, endHeight)
+	ret0, _ := ret[0].([]block.Block)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlocksByRange indicates an expected call of GetBlocksByRange.
+func (mr *StateMockRecorder) GetBlocksByRange(startHeight, endHeight any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlocksByRange", reflect.TypeOf((*State)(nil).GetBlocksByRange), startHeight, endHeight)
+}
+
 // GetLastAccepted mocks base method.
 func (m *State) GetLastAccepted() ids.ID {
 	m.ctrl.T.Helper()
@@ -235,6 +265,21 @@ func (mr *StateMockRecorder) GetTx(txID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTx", reflect.TypeOf((*State)(nil).GetTx), txID)
 }
 
+// GetTxsByAsset mocks base method.
+func (m *State) GetTxsByAsset(assetID, previous ids.ID, limit int) ([]*txs.Tx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTxsByAsset", assetID, previous, limit)
+	ret0, _ := ret[0].([]*txs.Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTxsByAsset indicates an expected call of GetTxsByAsset.
+func (mr *StateMockRecorder) GetTxsByAsset(assetID, previous, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxsByAsset", reflect.TypeOf((*State)(nil).GetTxsByAsset), assetID, previous, limit)
+}
+
 // GetUTXO mocks base method.
 func (m *State) GetUTXO(utxoID ids.ID) (*avax.UTXO, error) {
 	m.ctrl.T.Helper()
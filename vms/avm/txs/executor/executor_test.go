@@ -15,6 +15,7 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/units"
 	"github.com/ava-labs/avalanchego/vms/avm/block"
 	"github.com/ava-labs/avalanchego/vms/avm/fxs"
@@ -25,7 +26,10 @@ import (
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 )
 
-const trackChecksums = false
+const (
+	trackChecksums = false
+	indexAssetTxs  = false
+)
 
 var (
 	chainID = ids.ID{5, 4, 3, 2, 1}
@@ -45,7 +49,7 @@ func TestBaseTxExecutor(t *testing.T) {
 	db := memdb.New()
 	vdb := versiondb.New(db)
 	registerer := prometheus.NewRegistry()
-	state, err := state.New(vdb, parser, registerer, trackChecksums)
+	state, err := state.New(logging.NoLog{}, vdb, parser, registerer, trackChecksums, indexAssetTxs)
 	require.NoError(err)
 
 	utxoID := avax.UTXOID{
@@ -152,7 +156,7 @@ func TestCreateAssetTxExecutor(t *testing.T) {
 	db := memdb.New()
 	vdb := versiondb.New(db)
 	registerer := prometheus.NewRegistry()
-	state, err := state.New(vdb, parser, registerer, trackChecksums)
+	state, err := state.New(logging.NoLog{}, vdb, parser, registerer, trackChecksums, indexAssetTxs)
 	require.NoError(err)
 
 	utxoID := avax.UTXOID{
@@ -297,7 +301,7 @@ func TestOperationTxExecutor(t *testing.T) {
 	db := memdb.New()
 	vdb := versiondb.New(db)
 	registerer := prometheus.NewRegistry()
-	state, err := state.New(vdb, parser, registerer, trackChecksums)
+	state, err := state.New(logging.NoLog{}, vdb, parser, registerer, trackChecksums, indexAssetTxs)
 	require.NoError(err)
 
 	outputOwners := secp256k1fx.OutputOwners{
@@ -52,8 +52,5 @@ func (m *mempool) RequestBuildBlock() {
 		return
 	}
 
-	select {
-	case m.toEngine <- common.PendingTxs:
-	default:
-	}
+	common.DrainToEngine(m.toEngine, common.PendingTxs)
 }
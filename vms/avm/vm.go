@@ -222,10 +222,12 @@ func (vm *VM) Initialize(
 	vm.Spender = utxo.NewSpender(&vm.clock, codec)
 
 	state, err := state.New(
+		vm.ctx.Log,
 		vm.db,
 		vm.parser,
 		vm.registerer,
 		avmConfig.ChecksumsEnabled,
+		avmConfig.IndexTransactions,
 	)
 	if err != nil {
 		return err
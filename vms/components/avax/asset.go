@@ -30,7 +30,7 @@ func (asset *Asset) Verify() error {
 	switch {
 	case asset == nil:
 		return errNilAssetID
-	case asset.ID == ids.Empty:
+	case asset.ID.IsZero():
 		return errEmptyAssetID
 	default:
 		return nil
@@ -12,3 +12,18 @@ const (
 type Addressable interface {
 	Addresses() [][]byte
 }
+
+// WrappedOutput is the interface a feature extension must provide for outputs
+// that wrap another output, such as stakeable locked outputs
+type WrappedOutput interface {
+	// InnerOutput returns the wrapped output
+	InnerOutput() TransferableOut
+}
+
+// TimedOutput is the interface a feature extension must provide for its
+// outputs to be considered timelocked
+type TimedOutput interface {
+	// UnlockTime returns the unix time, in seconds, at which the output can
+	// first be spent
+	UnlockTime() uint64
+}
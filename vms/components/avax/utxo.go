@@ -5,11 +5,16 @@ package avax
 
 import (
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 )
 
 var (
+	ErrUnknownOutputType = errors.New("unknown output type")
+
 	errNilUTXO   = errors.New("nil utxo is not valid")
 	errEmptyUTXO = errors.New("empty utxo is not valid")
 
@@ -33,3 +38,38 @@ func (utxo *UTXO) Verify() error {
 		return verify.All(&utxo.UTXOID, &utxo.Asset, utxo.Out)
 	}
 }
+
+// IsSpendable returns false if the output of the UTXO is timelocked at [at].
+// Outputs that aren't a TimedOutput are always spendable.
+func (utxo *UTXO) IsSpendable(at time.Time) bool {
+	out, ok := utxo.Out.(TimedOutput)
+	if !ok {
+		return true
+	}
+
+	unlockTime := out.UnlockTime()
+	if unlockTime == 0 {
+		return true
+	}
+	unix := at.Unix()
+	return unix >= 0 && uint64(unix) >= unlockTime
+}
+
+// Owners returns the owners that can spend the output of the UTXO. Wrapped
+// outputs are unwrapped until a secp256k1fx output is found.
+func (utxo *UTXO) Owners() ([]*secp256k1fx.OutputOwners, error) {
+	return outputOwners(utxo.Out)
+}
+
+func outputOwners(out verify.State) ([]*secp256k1fx.OutputOwners, error) {
+	switch out := out.(type) {
+	case *secp256k1fx.TransferOutput:
+		return []*secp256k1fx.OutputOwners{&out.OutputOwners}, nil
+	case *secp256k1fx.MintOutput:
+		return []*secp256k1fx.OutputOwners{&out.OutputOwners}, nil
+	case WrappedOutput:
+		return outputOwners(out.InnerOutput())
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrUnknownOutputType, out)
+	}
+}
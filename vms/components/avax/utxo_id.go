@@ -44,7 +44,7 @@ func (utxo *UTXOID) InputSource() (ids.ID, uint32) {
 
 // InputID returns a unique ID of the UTXO that this input is spending
 func (utxo *UTXOID) InputID() ids.ID {
-	if utxo.id == ids.Empty {
+	if utxo.id.IsZero() {
 		utxo.id = utxo.TxID.Prefix(uint64(utxo.OutputIndex))
 	}
 	return utxo.id
@@ -103,6 +103,17 @@ func NewUTXOState(
 	return s, s.initChecksum()
 }
 
+// NewUncachedUTXOGetter returns a UTXOGetter that reads the UTXOs written to
+// [db] by a UTXOState. UTXOs aren't cached, so modifications made to [db] by
+// other UTXOStates are immediately visible.
+func NewUncachedUTXOGetter(db database.Database, codec codec.Manager) UTXOGetter {
+	return &utxoState{
+		codec:     codec,
+		utxoCache: &cache.Empty[ids.ID, *UTXO]{},
+		utxoDB:    prefixdb.New(utxoPrefix, db),
+	}
+}
+
 func NewMeteredUTXOState(
 	db database.Database,
 	codec codec.Manager,
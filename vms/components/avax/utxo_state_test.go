@@ -92,3 +92,51 @@ func TestUTXOState(t *testing.T) {
 	require.NoError(err)
 	require.Equal([]ids.ID{utxoID}, utxoIDs)
 }
+
+func TestUncachedUTXOGetter(t *testing.T) {
+	require := require.New(t)
+
+	utxo := &UTXO{
+		UTXOID: UTXOID{
+			TxID:        ids.GenerateTestID(),
+			OutputIndex: 0,
+		},
+		Asset: Asset{ID: ids.GenerateTestID()},
+		Out: &secp256k1fx.TransferOutput{
+			Amt: 12345,
+			OutputOwners: secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+			},
+		},
+	}
+	utxoID := utxo.InputID()
+
+	c := linearcodec.NewDefault()
+	manager := codec.NewDefaultManager()
+
+	require.NoError(c.RegisterType(&secp256k1fx.TransferOutput{}))
+	require.NoError(manager.RegisterCodec(codecVersion, c))
+
+	db := memdb.New()
+	s, err := NewUTXOState(db, manager, trackChecksum)
+	require.NoError(err)
+	getter := NewUncachedUTXOGetter(db, manager)
+
+	_, err = getter.GetUTXO(utxoID)
+	require.Equal(database.ErrNotFound, err)
+
+	// A previously missing UTXO is returned once it is written.
+	require.NoError(s.PutUTXO(utxo))
+
+	readUTXO, err := getter.GetUTXO(utxoID)
+	require.NoError(err)
+	require.Equal(utxoID, readUTXO.InputID())
+	require.Equal(utxo, readUTXO)
+
+	// A previously returned UTXO is missing once it is deleted.
+	require.NoError(s.DeleteUTXO(utxoID))
+
+	_, err = getter.GetUTXO(utxoID)
+	require.Equal(database.ErrNotFound, err)
+}
@@ -5,12 +5,14 @@ package avax
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/ava-labs/avalanchego/codec"
 	"github.com/ava-labs/avalanchego/codec/linearcodec"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 )
 
@@ -111,3 +113,129 @@ func TestUTXOSerialize(t *testing.T) {
 	require.NoError(err)
 	require.Equal(expected, utxoBytes)
 }
+
+func TestUTXOIsSpendable(t *testing.T) {
+	const locktime = 1_000
+	var (
+		unlocked = time.Unix(locktime, 0)
+		locked   = unlocked.Add(-time.Second)
+	)
+	tests := []struct {
+		name     string
+		out      verify.State
+		at       time.Time
+		expected bool
+	}{
+		{
+			name:     "not timelocked",
+			out:      &TestTransferable{Val: 1},
+			at:       time.Unix(0, 0),
+			expected: true,
+		},
+		{
+			name: "zero locktime",
+			out: &secp256k1fx.TransferOutput{
+				Amt: 1,
+			},
+			at:       time.Unix(0, 0),
+			expected: true,
+		},
+		{
+			name: "before locktime",
+			out: &secp256k1fx.TransferOutput{
+				Amt: 1,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Locktime: locktime,
+				},
+			},
+			at:       locked,
+			expected: false,
+		},
+		{
+			name: "at locktime",
+			out: &secp256k1fx.TransferOutput{
+				Amt: 1,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Locktime: locktime,
+				},
+			},
+			at:       unlocked,
+			expected: true,
+		},
+		{
+			name: "after locktime",
+			out: &secp256k1fx.TransferOutput{
+				Amt: 1,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Locktime: locktime,
+				},
+			},
+			at:       unlocked.Add(time.Hour),
+			expected: true,
+		},
+		{
+			name: "before unix epoch",
+			out: &secp256k1fx.MintOutput{
+				OutputOwners: secp256k1fx.OutputOwners{
+					Locktime: locktime,
+				},
+			},
+			at:       time.Unix(-locktime, 0),
+			expected: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			utxo := &UTXO{Out: test.out}
+			require.Equal(t, test.expected, utxo.IsSpendable(test.at))
+		})
+	}
+}
+
+func TestUTXOOwners(t *testing.T) {
+	owners := secp256k1fx.OutputOwners{
+		Locktime:  1,
+		Threshold: 2,
+		Addrs: []ids.ShortID{
+			ids.GenerateTestShortID(),
+			ids.GenerateTestShortID(),
+		},
+	}
+	tests := []struct {
+		name           string
+		out            verify.State
+		expectedOwners []*secp256k1fx.OutputOwners
+		expectedErr    error
+	}{
+		{
+			name: "transfer output",
+			out: &secp256k1fx.TransferOutput{
+				Amt:          1,
+				OutputOwners: owners,
+			},
+			expectedOwners: []*secp256k1fx.OutputOwners{&owners},
+		},
+		{
+			name: "mint output",
+			out: &secp256k1fx.MintOutput{
+				OutputOwners: owners,
+			},
+			expectedOwners: []*secp256k1fx.OutputOwners{&owners},
+		},
+		{
+			name:        "unknown output",
+			out:         &TestTransferable{Val: 1},
+			expectedErr: ErrUnknownOutputType,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			utxo := &UTXO{Out: test.out}
+			owners, err := utxo.Owners()
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expectedOwners, owners)
+		})
+	}
+}
@@ -3,7 +3,12 @@
 
 package gas
 
-import "github.com/ava-labs/avalanchego/utils/math"
+import (
+	"errors"
+	"math"
+
+	safemath "github.com/ava-labs/avalanchego/utils/math"
+)
 
 const (
 	Bandwidth Dimension = iota
@@ -14,6 +19,8 @@ const (
 	NumDimensions = iota
 )
 
+var ErrInvalidScaleFactor = errors.New("invalid scale factor")
+
 type (
 	Dimension  uint
 	Dimensions [NumDimensions]uint64
@@ -26,7 +33,7 @@ func (d Dimensions) Add(os ...*Dimensions) (Dimensions, error) {
 	var err error
 	for _, o := range os {
 		for i := range o {
-			d[i], err = math.Add(d[i], o[i])
+			d[i], err = safemath.Add(d[i], o[i])
 			if err != nil {
 				return d, err
 			}
@@ -42,7 +49,7 @@ func (d Dimensions) Sub(os ...*Dimensions) (Dimensions, error) {
 	var err error
 	for _, o := range os {
 		for i := range o {
-			d[i], err = math.Sub(d[i], o[i])
+			d[i], err = safemath.Sub(d[i], o[i])
 			if err != nil {
 				return d, err
 			}
@@ -51,17 +58,43 @@ func (d Dimensions) Sub(os ...*Dimensions) (Dimensions, error) {
 	return d, nil
 }
 
+// Scale returns d * factor, with each dimension rounded to the nearest integer.
+// Halfway cases are rounded away from zero.
+//
+// Because the multiplication is performed with float64 precision, dimensions
+// larger than 2^53 may not be scaled exactly.
+//
+// If factor is negative or NaN, an error is returned. If overflow occurs, an
+// error is returned.
+func (d Dimensions) Scale(factor float64) (Dimensions, error) {
+	if factor < 0 || math.IsNaN(factor) {
+		return d, ErrInvalidScaleFactor
+	}
+
+	var scaled Dimensions
+	for i := range d {
+		v := math.Round(float64(d[i]) * factor)
+		// float64(math.MaxUint64) rounds up to 2^64, so any value at least that
+		// large doesn't fit into a uint64.
+		if v >= float64(math.MaxUint64) {
+			return d, safemath.ErrOverflow
+		}
+		scaled[i] = uint64(v)
+	}
+	return scaled, nil
+}
+
 // ToGas returns d · weights.
 //
 // If overflow occurs, an error is returned.
 func (d Dimensions) ToGas(weights Dimensions) (Gas, error) {
 	var res uint64
 	for i := range d {
-		v, err := math.Mul(d[i], weights[i])
+		v, err := safemath.Mul(d[i], weights[i])
 		if err != nil {
 			return 0, err
 		}
-		res, err = math.Add(res, v)
+		res, err = safemath.Add(res, v)
 		if err != nil {
 			return 0, err
 		}
@@ -427,6 +427,129 @@ func Test_Dimensions_ToGas(t *testing.T) {
 	}
 }
 
+func Test_Dimensions_Scale(t *testing.T) {
+	tests := []struct {
+		name        string
+		dimensions  Dimensions
+		factor      float64
+		expected    Dimensions
+		expectedErr error
+	}{
+		{
+			name: "zero factor",
+			dimensions: Dimensions{
+				Bandwidth: 1,
+				DBRead:    2,
+				DBWrite:   3,
+				Compute:   4,
+			},
+			factor:      0,
+			expected:    Dimensions{},
+			expectedErr: nil,
+		},
+		{
+			name: "integer factor",
+			dimensions: Dimensions{
+				Bandwidth: 1,
+				DBRead:    2,
+				DBWrite:   3,
+				Compute:   4,
+			},
+			factor: 2,
+			expected: Dimensions{
+				Bandwidth: 2,
+				DBRead:    4,
+				DBWrite:   6,
+				Compute:   8,
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "rounds to nearest",
+			dimensions: Dimensions{
+				Bandwidth: 1,
+				DBRead:    3,
+				DBWrite:   5,
+				Compute:   100,
+			},
+			factor: 1.5,
+			expected: Dimensions{
+				Bandwidth: 2,   // 1.5 rounds up
+				DBRead:    5,   // 4.5 rounds up
+				DBWrite:   8,   // 7.5 rounds up
+				Compute:   150, // exact
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "rounds down",
+			dimensions: Dimensions{
+				Bandwidth: 1,
+				DBRead:    2,
+				DBWrite:   3,
+				Compute:   4,
+			},
+			factor: 1.1,
+			expected: Dimensions{
+				Bandwidth: 1,
+				DBRead:    2,
+				DBWrite:   3,
+				Compute:   4,
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "overflow",
+			dimensions: Dimensions{
+				Compute: math.MaxUint64,
+			},
+			factor:      1.5,
+			expectedErr: safemath.ErrOverflow,
+		},
+		{
+			name: "max uint64 overflows due to float64 precision",
+			dimensions: Dimensions{
+				Compute: math.MaxUint64,
+			},
+			factor:      1,
+			expectedErr: safemath.ErrOverflow,
+		},
+		{
+			name: "largest float64 below 2^64",
+			dimensions: Dimensions{
+				Compute: 1 << 63,
+			},
+			factor: 1.9999999999999998,
+			expected: Dimensions{
+				Compute: math.MaxUint64 - 2047,
+			},
+			expectedErr: nil,
+		},
+		{
+			name:        "negative factor",
+			factor:      -1,
+			expectedErr: ErrInvalidScaleFactor,
+		},
+		{
+			name:        "NaN factor",
+			factor:      math.NaN(),
+			expectedErr: ErrInvalidScaleFactor,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			actual, err := test.dimensions.Scale(test.factor)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				return
+			}
+			require.Equal(test.expected, actual)
+		})
+	}
+}
+
 func Benchmark_Dimensions_Add(b *testing.B) {
 	lhs := Dimensions{600, 10, 10, 1000}
 	rhs := []*Dimensions{
@@ -9,6 +9,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	safemath "github.com/ava-labs/avalanchego/utils/math"
 )
 
 var calculatePriceTests = []struct {
@@ -80,16 +82,53 @@ var calculatePriceTests = []struct {
 }
 
 func Test_Gas_Cost(t *testing.T) {
-	require := require.New(t)
+	tests := []struct {
+		name        string
+		gas         Gas
+		price       Price
+		expected    uint64
+		expectedErr error
+	}{
+		{
+			name:     "zero gas",
+			gas:      0,
+			price:    math.MaxUint64,
+			expected: 0,
+		},
+		{
+			name:     "zero price",
+			gas:      math.MaxUint64,
+			price:    0,
+			expected: 0,
+		},
+		{
+			name:     "normal",
+			gas:      40,
+			price:    100,
+			expected: 4000,
+		},
+		{
+			name:     "max uint64",
+			gas:      math.MaxUint64,
+			price:    1,
+			expected: math.MaxUint64,
+		},
+		{
+			name:        "overflow",
+			gas:         math.MaxUint64/2 + 1,
+			price:       2,
+			expectedErr: safemath.ErrOverflow,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
 
-	const (
-		gas      Gas    = 40
-		price    Price  = 100
-		expected uint64 = 4000
-	)
-	actual, err := gas.Cost(price)
-	require.NoError(err)
-	require.Equal(expected, actual)
+			actual, err := test.gas.Cost(test.price)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expected, actual)
+		})
+	}
 }
 
 func Test_Gas_AddPerSecond(t *testing.T) {
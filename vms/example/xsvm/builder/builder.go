@@ -61,10 +61,7 @@ func (b *builder) AddTx(_ context.Context, newTx *tx.Tx) error {
 		return err
 	}
 	b.pendingTxs.Put(txID, newTx)
-	select {
-	case b.engineChan <- common.PendingTxs:
-	default:
-	}
+	common.DrainToEngine(b.engineChan, common.PendingTxs)
 	return nil
 }
 
@@ -83,10 +80,7 @@ func (b *builder) BuildBlock(ctx context.Context, blockContext *smblock.Context)
 		if b.pendingTxs.Len() == 0 {
 			return
 		}
-		select {
-		case b.engineChan <- common.PendingTxs:
-		default:
-		}
+		common.DrainToEngine(b.engineChan, common.PendingTxs)
 	}()
 
 	parentTimestamp := preferredBlk.Timestamp()
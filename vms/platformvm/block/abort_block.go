@@ -61,17 +61,26 @@ func (*ApricotAbortBlock) Txs() []*txs.Tx {
 	return nil
 }
 
+func (*ApricotAbortBlock) TxIDs() []ids.ID {
+	return nil
+}
+
 func (b *ApricotAbortBlock) Visit(v Visitor) error {
 	return v.ApricotAbortBlock(b)
 }
 
 // NewApricotAbortBlock is kept for testing purposes only.
 // Following Banff activation and subsequent code cleanup, Apricot Abort blocks
-// should be only verified (upon bootstrap), never created anymore
+// should be only verified (upon bootstrap), never created anymore.
+// The parent must be non-empty and the height must be non-zero.
 func NewApricotAbortBlock(
 	parentID ids.ID,
 	height uint64,
 ) (*ApricotAbortBlock, error) {
+	if err := verifyOptionBlockFields(parentID, height); err != nil {
+		return nil, err
+	}
+
 	blk := &ApricotAbortBlock{
 		CommonBlock: CommonBlock{
 			PrntID: parentID,
@@ -50,3 +50,32 @@ func TestNewApricotAbortBlock(t *testing.T) {
 	require.Equal(parentID, blk.Parent())
 	require.Equal(height, blk.Height())
 }
+
+func TestNewApricotAbortBlockInvalidFields(t *testing.T) {
+	tests := []struct {
+		name        string
+		parentID    ids.ID
+		height      uint64
+		expectedErr error
+	}{
+		{
+			name:        "empty parent ID",
+			parentID:    ids.Empty,
+			height:      1,
+			expectedErr: ErrEmptyParentID,
+		},
+		{
+			name:        "zero height",
+			parentID:    ids.GenerateTestID(),
+			height:      0,
+			expectedErr: ErrZeroHeight,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			blk, err := NewApricotAbortBlock(test.parentID, test.height)
+			require.ErrorIs(t, err, test.expectedErr)
+			require.Nil(t, blk)
+		})
+	}
+}
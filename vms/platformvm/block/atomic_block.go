@@ -36,6 +36,10 @@ func (b *ApricotAtomicBlock) Txs() []*txs.Tx {
 	return []*txs.Tx{b.Tx}
 }
 
+func (b *ApricotAtomicBlock) TxIDs() []ids.ID {
+	return []ids.ID{b.Tx.ID()}
+}
+
 func (b *ApricotAtomicBlock) Visit(v Visitor) error {
 	return v.ApricotAtomicBlock(b)
 }
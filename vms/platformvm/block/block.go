@@ -4,14 +4,26 @@
 package block
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils/units"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 )
 
+// MaxBlockSize is the maximum size of a block that can be marshalled or
+// parsed with Codec, which is configured with this limit.
+const MaxBlockSize = 256 * units.KiB
+
+var (
+	ErrBlockTooLarge = errors.New("block is too large")
+	ErrEmptyParentID = errors.New("parent ID is empty")
+	ErrZeroHeight    = errors.New("only the genesis block can have height 0")
+)
+
 // Block defines the common stateless interface for all blocks
 type Block interface {
 	snow.ContextInitializable
@@ -20,9 +32,16 @@ type Block interface {
 	Bytes() []byte
 	Height() uint64
 
+	// Size returns the length of the serialized block
+	Size() int
+
 	// Txs returns list of transactions contained in the block
 	Txs() []*txs.Tx
 
+	// TxIDs returns the IDs of the transactions contained in the block, in
+	// the same order as [Txs]
+	TxIDs() []ids.ID
+
 	// Visit calls [visitor] with this block's concrete type
 	Visit(visitor Visitor) error
 
@@ -36,6 +55,38 @@ type BanffBlock interface {
 	Timestamp() time.Time
 }
 
+// ValidateSize returns an error if [blk] is larger than [MaxBlockSize].
+func ValidateSize(blk Block) error {
+	if size := blk.Size(); size > MaxBlockSize {
+		return fmt.Errorf("%w: %d > %d", ErrBlockTooLarge, size, MaxBlockSize)
+	}
+	return nil
+}
+
+// verifyOptionBlockFields verifies that a commit or abort block, which must
+// follow a proposal block, has a parent and is not at the genesis height.
+func verifyOptionBlockFields(parentID ids.ID, height uint64) error {
+	switch {
+	case parentID.IsZero():
+		return ErrEmptyParentID
+	case height == 0:
+		return ErrZeroHeight
+	default:
+		return nil
+	}
+}
+
+func txIDs(txs []*txs.Tx) []ids.ID {
+	if len(txs) == 0 {
+		return nil
+	}
+	txIDs := make([]ids.ID, len(txs))
+	for i, tx := range txs {
+		txIDs[i] = tx.ID()
+	}
+	return txIDs
+}
+
 func initialize(blk Block, commonBlk *CommonBlock) error {
 	// We serialize this block as a pointer so that it can be deserialized into
 	// a Block
@@ -0,0 +1,143 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package block
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+)
+
+func TestValidateSize(t *testing.T) {
+	tests := []struct {
+		name        string
+		size        int
+		expectedErr error
+	}{
+		{
+			name:        "empty",
+			size:        0,
+			expectedErr: nil,
+		},
+		{
+			name:        "max size",
+			size:        MaxBlockSize,
+			expectedErr: nil,
+		},
+		{
+			name:        "too large",
+			size:        MaxBlockSize + 1,
+			expectedErr: ErrBlockTooLarge,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			blk := &ApricotCommitBlock{}
+			blk.CommonBlock.initialize(make([]byte, test.size))
+			require.Equal(t, test.size, blk.Size())
+
+			err := ValidateSize(blk)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestCodecMaxSize(t *testing.T) {
+	// Codec must reject blocks that ValidateSize would reject
+	_, err := Parse(Codec, make([]byte, MaxBlockSize+1))
+	require.ErrorIs(t, err, codec.ErrUnmarshalTooBig)
+}
+
+func TestTxIDs(t *testing.T) {
+	var (
+		timestamp = time.Now().Truncate(time.Second)
+		parentID  = ids.GenerateTestID()
+		height    = uint64(1337)
+	)
+
+	newTx := func(t *testing.T) *txs.Tx {
+		tx := &txs.Tx{
+			Unsigned: &txs.BaseTx{
+				BaseTx: avax.BaseTx{
+					NetworkID:    constants.UnitTestID,
+					BlockchainID: ids.GenerateTestID(),
+				},
+			},
+		}
+		require.NoError(t, tx.Initialize(txs.Codec))
+		return tx
+	}
+
+	tests := []struct {
+		name     string
+		newBlock func(t *testing.T) (Block, []*txs.Tx)
+	}{
+		{
+			name: "banff proposal block",
+			newBlock: func(t *testing.T) (Block, []*txs.Tx) {
+				decisionTxs := []*txs.Tx{newTx(t), newTx(t)}
+				proposalTx := newTx(t)
+				blk, err := NewBanffProposalBlock(timestamp, parentID, height, proposalTx, decisionTxs)
+				require.NoError(t, err)
+				return blk, append(decisionTxs, proposalTx)
+			},
+		},
+		{
+			name: "banff standard block",
+			newBlock: func(t *testing.T) (Block, []*txs.Tx) {
+				blkTxs := []*txs.Tx{newTx(t), newTx(t)}
+				blk, err := NewBanffStandardBlock(timestamp, parentID, height, blkTxs)
+				require.NoError(t, err)
+				return blk, blkTxs
+			},
+		},
+		{
+			name: "banff commit block",
+			newBlock: func(t *testing.T) (Block, []*txs.Tx) {
+				blk, err := NewBanffCommitBlock(timestamp, parentID, height)
+				require.NoError(t, err)
+				return blk, nil
+			},
+		},
+		{
+			name: "apricot atomic block",
+			newBlock: func(t *testing.T) (Block, []*txs.Tx) {
+				tx := newTx(t)
+				blk, err := NewApricotAtomicBlock(parentID, height, tx)
+				require.NoError(t, err)
+				return blk, []*txs.Tx{tx}
+			},
+		},
+		{
+			name: "apricot proposal block",
+			newBlock: func(t *testing.T) (Block, []*txs.Tx) {
+				tx := newTx(t)
+				blk, err := NewApricotProposalBlock(parentID, height, tx)
+				require.NoError(t, err)
+				return blk, []*txs.Tx{tx}
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			blk, expectedTxs := test.newBlock(t)
+			require.Equal(expectedTxs, blk.Txs())
+
+			var expectedTxIDs []ids.ID
+			for _, tx := range expectedTxs {
+				expectedTxIDs = append(expectedTxIDs, tx.ID())
+			}
+			require.Equal(expectedTxIDs, blk.TxIDs())
+		})
+	}
+}
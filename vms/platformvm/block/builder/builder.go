@@ -48,6 +48,7 @@ var (
 	ErrEndOfTime                 = errors.New("program time is suspiciously far in the future")
 	ErrNoPendingBlocks           = errors.New("no pending blocks")
 	errMissingPreferredState     = errors.New("missing preferred block state")
+	errPreferredHeightNotFound   = errors.New("no block to build on at preferred height")
 	errCalculatingNextStakerTime = errors.New("failed calculating next staker time")
 )
 
@@ -78,6 +79,17 @@ type Builder interface {
 	//
 	// Note: This function does not call the consensus engine.
 	PackAllBlockTxs() ([]*txs.Tx, error)
+
+	// SetPreferredHeight causes the next block to be built on top of the
+	// ancestor of the preferred block at [height], rather than on top of the
+	// preferred block. The preferred height is only used for the next block
+	// production attempt.
+	//
+	// This is intended to support tests that need to produce a block at a
+	// specific height.
+	//
+	// Invariant: Assumes the context lock is held when calling.
+	SetPreferredHeight(height uint64)
 }
 
 // builder implements a simple builder to convert txs into valid blocks
@@ -87,6 +99,11 @@ type builder struct {
 	txExecutorBackend *txexecutor.Backend
 	blkManager        blockexecutor.Manager
 
+	// If [hasPreferredHeight] is true, the next block will be built on top of
+	// the preferred block's ancestor at [preferredHeight].
+	hasPreferredHeight bool
+	preferredHeight    uint64
+
 	// resetTimer is used to signal that the block builder timer should update
 	// when it will trigger building of a block.
 	resetTimer chan struct{}
@@ -230,7 +247,10 @@ func (b *builder) BuildBlockWithContext(
 	b.txExecutorBackend.Ctx.Log.Debug("starting to attempt to build a block")
 
 	// Get the block to build on top of and retrieve the new block's context.
-	preferredID := b.blkManager.Preferred()
+	preferredID, err := b.parentID()
+	if err != nil {
+		return nil, err
+	}
 	preferred, err := b.blkManager.GetBlock(preferredID)
 	if err != nil {
 		return nil, err
@@ -263,10 +283,52 @@ func (b *builder) BuildBlockWithContext(
 	if err != nil {
 		return nil, err
 	}
+	if err := block.ValidateSize(statelessBlk); err != nil {
+		return nil, err
+	}
 
 	return b.blkManager.NewBlock(statelessBlk), nil
 }
 
+func (b *builder) SetPreferredHeight(height uint64) {
+	b.hasPreferredHeight = true
+	b.preferredHeight = height
+}
+
+// parentID returns the ID of the block that the next block should be built on
+// top of.
+//
+// Invariant: Assumes the context lock is held when calling.
+func (b *builder) parentID() (ids.ID, error) {
+	preferredID := b.blkManager.Preferred()
+	if !b.hasPreferredHeight {
+		return preferredID, nil
+	}
+
+	height := b.preferredHeight
+	b.hasPreferredHeight = false
+
+	// Only the last accepted block and its processing descendants can be
+	// built on, so the search stops at the last accepted block.
+	lastAcceptedID := b.blkManager.LastAccepted()
+	blkID := preferredID
+	for {
+		blk, err := b.blkManager.GetStatelessBlock(blkID)
+		if err != nil {
+			return ids.Empty, err
+		}
+
+		blkHeight := blk.Height()
+		switch {
+		case blkHeight == height:
+			return blkID, nil
+		case blkHeight < height, blkID == lastAcceptedID:
+			return ids.Empty, fmt.Errorf("%w: %d", errPreferredHeightNotFound, height)
+		}
+		blkID = blk.Parent()
+	}
+}
+
 func (b *builder) PackAllBlockTxs() ([]*txs.Tx, error) {
 	preferredID := b.blkManager.Preferred()
 	preferredState, ok := b.blkManager.GetState(preferredID)
@@ -599,6 +661,7 @@ func executeTx(
 	}
 
 	txInputs, _, _, err := txexecutor.StandardTx(
+		ctx,
 		backend,
 		feeCalculator,
 		tx,
@@ -77,6 +77,59 @@ func TestBuildBlockBasic(t *testing.T) {
 	require.NoError(env.mempool.GetDropReason(txID))
 }
 
+func TestBuildBlockAtPreferredHeight(t *testing.T) {
+	require := require.New(t)
+
+	env := newEnvironment(t, upgradetest.Latest)
+	env.ctx.Lock.Lock()
+	defer env.ctx.Lock.Unlock()
+
+	subnetID := testSubnet1.ID()
+	wallet := newWallet(t, env, walletConfig{
+		subnetIDs: []ids.ID{subnetID},
+	})
+
+	tx, err := wallet.IssueCreateChainTx(
+		subnetID,
+		nil,
+		constants.AVMID,
+		nil,
+		"chain name",
+	)
+	require.NoError(err)
+
+	lastAcceptedID := env.blkManager.LastAccepted()
+	lastAccepted, err := env.blkManager.GetBlock(lastAcceptedID)
+	require.NoError(err)
+
+	// Build and prefer a block on top of the last accepted block
+	require.NoError(env.mempool.Add(tx))
+	childBlk, err := env.Builder.BuildBlock(context.Background())
+	require.NoError(err)
+	require.NoError(childBlk.Verify(context.Background()))
+	require.True(env.blkManager.SetPreference(childBlk.ID()))
+
+	// Requesting a height above the preferred block fails
+	env.Builder.SetPreferredHeight(childBlk.Height() + 1)
+	require.NoError(env.mempool.Add(tx))
+	_, err = env.Builder.BuildBlock(context.Background())
+	require.ErrorIs(err, errPreferredHeightNotFound)
+
+	// Build a sibling of the preferred block
+	env.Builder.SetPreferredHeight(lastAccepted.Height())
+	siblingBlk, err := env.Builder.BuildBlock(context.Background())
+	require.NoError(err)
+	require.Equal(lastAcceptedID, siblingBlk.Parent())
+	require.Equal(childBlk.Height(), siblingBlk.Height())
+
+	// The preferred height is only used once
+	require.NoError(env.mempool.Add(tx))
+	nextBlk, err := env.Builder.BuildBlock(context.Background())
+	require.NoError(err)
+	require.Equal(childBlk.ID(), nextBlk.Parent())
+	require.Equal(childBlk.Height()+1, nextBlk.Height())
+}
+
 func TestBuildBlockDoesNotBuildWithEmptyMempool(t *testing.T) {
 	require := require.New(t)
 
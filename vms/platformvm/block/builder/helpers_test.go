@@ -142,7 +142,7 @@ func newEnvironment(t *testing.T, f upgradetest.Fork) *environment { //nolint:un
 	metrics, err := metrics.New(registerer)
 	require.NoError(err)
 
-	res.mempool, err = mempool.New("mempool", registerer, nil)
+	res.mempool, err = mempool.New("mempool", res.config.DynamicFeeConfig.Weights, registerer, nil)
 	require.NoError(err)
 
 	res.blkManager = blockexecutor.NewManager(
@@ -249,6 +249,7 @@ func addSubnet(t *testing.T, env *environment) {
 
 	feeCalculator := state.PickFeeCalculator(env.config, stateDiff)
 	_, _, _, err = txexecutor.StandardTx(
+		context.Background(),
 		&env.backend,
 		feeCalculator,
 		testSubnet1,
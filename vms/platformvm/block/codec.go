@@ -39,7 +39,7 @@ func init() {
 		)
 	}
 
-	Codec = codec.NewDefaultManager()
+	Codec = codec.NewManager(MaxBlockSize)
 	GenesisCodec = codec.NewManager(math.MaxInt32)
 	errs.Add(
 		Codec.RegisterCodec(CodecVersion, c),
@@ -61,13 +61,36 @@ func (*ApricotCommitBlock) Txs() []*txs.Tx {
 	return nil
 }
 
+func (*ApricotCommitBlock) TxIDs() []ids.ID {
+	return nil
+}
+
 func (b *ApricotCommitBlock) Visit(v Visitor) error {
 	return v.ApricotCommitBlock(b)
 }
 
+// NewApricotCommitBlock returns a commit block at [height] following the
+// proposal block [parentID]. The parent must be non-empty and the height must
+// be non-zero.
 func NewApricotCommitBlock(
 	parentID ids.ID,
 	height uint64,
+) (*ApricotCommitBlock, error) {
+	if err := verifyOptionBlockFields(parentID, height); err != nil {
+		return nil, err
+	}
+	return newApricotCommitBlock(parentID, height)
+}
+
+// NewApricotGenesisBlock returns the commit block at height 0 that the P-chain
+// uses as its genesis block. [genesisID] is the hash of the genesis bytes.
+func NewApricotGenesisBlock(genesisID ids.ID) (*ApricotCommitBlock, error) {
+	return newApricotCommitBlock(genesisID, 0)
+}
+
+func newApricotCommitBlock(
+	parentID ids.ID,
+	height uint64,
 ) (*ApricotCommitBlock, error) {
 	blk := &ApricotCommitBlock{
 		CommonBlock: CommonBlock{
@@ -50,3 +50,46 @@ func TestNewApricotCommitBlock(t *testing.T) {
 	require.Equal(parentID, blk.Parent())
 	require.Equal(height, blk.Height())
 }
+
+func TestNewApricotCommitBlockInvalidFields(t *testing.T) {
+	tests := []struct {
+		name        string
+		parentID    ids.ID
+		height      uint64
+		expectedErr error
+	}{
+		{
+			name:        "empty parent ID",
+			parentID:    ids.Empty,
+			height:      1,
+			expectedErr: ErrEmptyParentID,
+		},
+		{
+			name:        "zero height",
+			parentID:    ids.GenerateTestID(),
+			height:      0,
+			expectedErr: ErrZeroHeight,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			blk, err := NewApricotCommitBlock(test.parentID, test.height)
+			require.ErrorIs(t, err, test.expectedErr)
+			require.Nil(t, blk)
+		})
+	}
+}
+
+func TestNewApricotGenesisBlock(t *testing.T) {
+	require := require.New(t)
+
+	genesisID := ids.GenerateTestID()
+	blk, err := NewApricotGenesisBlock(genesisID)
+	require.NoError(err)
+
+	// Make sure the block is initialized
+	require.NotEmpty(blk.Bytes())
+
+	require.Equal(genesisID, blk.Parent())
+	require.Zero(blk.Height())
+}
@@ -40,3 +40,7 @@ func (b *CommonBlock) Bytes() []byte {
 func (b *CommonBlock) Height() uint64 {
 	return b.Hght
 }
+
+func (b *CommonBlock) Size() int {
+	return len(b.bytes)
+}
@@ -62,6 +62,7 @@ func (b *Block) VerifyWithContext(ctx context.Context, blockContext *smblock.Con
 		backend:           b.manager.backend,
 		txExecutorBackend: b.manager.txExecutorBackend,
 		pChainHeight:      blockContext.PChainHeight,
+		verifyCtx:         ctx,
 	})
 }
 
@@ -53,8 +53,13 @@ func TestBlockOptions(t *testing.T) {
 					},
 				}
 
+				// Option blocks must have a non-empty parent
 				return &Block{
-					Block:   &block.ApricotProposalBlock{},
+					Block: &block.ApricotProposalBlock{
+						CommonBlock: block.CommonBlock{
+							BlockID: ids.GenerateTestID(),
+						},
+					},
 					manager: manager,
 				}
 			},
@@ -4,6 +4,7 @@
 package executor
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
@@ -153,7 +154,7 @@ func newEnvironment(t *testing.T, ctrl *gomock.Controller, f upgradetest.Fork) *
 	metrics := metrics.Noop
 
 	var err error
-	res.mempool, err = mempool.New("mempool", registerer, nil)
+	res.mempool, err = mempool.New("mempool", res.config.DynamicFeeConfig.Weights, registerer, nil)
 	if err != nil {
 		panic(fmt.Errorf("failed to create mempool: %w", err))
 	}
@@ -254,6 +255,7 @@ func addSubnet(t testing.TB, env *environment) {
 
 	feeCalculator := state.PickFeeCalculator(env.config, stateDiff)
 	_, _, _, err = executor.StandardTx(
+		context.Background(),
 		env.backend,
 		feeCalculator,
 		testSubnet1,
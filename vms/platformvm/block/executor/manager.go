@@ -189,6 +189,7 @@ func (m *manager) VerifyTx(tx *txs.Tx) error {
 
 	feeCalculator := state.PickFeeCalculator(m.txExecutorBackend.Config, stateDiff)
 	_, _, _, err = executor.StandardTx(
+		context.TODO(),
 		m.txExecutorBackend,
 		feeCalculator,
 		tx,
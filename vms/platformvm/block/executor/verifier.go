@@ -4,6 +4,7 @@
 package executor
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -41,6 +42,9 @@ type verifier struct {
 	*backend
 	txExecutorBackend *executor.Backend
 	pChainHeight      uint64
+	// verifyCtx is the context the block is verified with. It is used to
+	// trace the execution of the block's txs.
+	verifyCtx context.Context
 }
 
 func (v *verifier) BanffAbortBlock(b *block.BanffAbortBlock) error {
@@ -226,6 +230,7 @@ func (v *verifier) ApricotAtomicBlock(b *block.ApricotAtomicBlock) error {
 
 	feeCalculator := txfee.NewSimpleCalculator(0)
 	onAcceptState, atomicInputs, atomicRequests, err := executor.AtomicTx(
+		v.verifyCtx,
 		v.txExecutorBackend,
 		feeCalculator,
 		parentID,
@@ -580,6 +585,7 @@ func (v *verifier) processStandardTxs(txs []*txs.Tx, feeCalculator txfee.Calcula
 	)
 	for _, tx := range txs {
 		txInputs, txAtomicRequests, onAccept, err := executor.StandardTx(
+			v.verifyCtx,
 			v.txExecutorBackend,
 			feeCalculator,
 			tx,
@@ -75,7 +75,7 @@ func newTestVerifier(t testing.TB, c testVerifierConfig) *verifier {
 		c.ValidatorFeeConfig = genesis.LocalParams.ValidatorFeeConfig
 	}
 
-	mempool, err := mempool.New("", prometheus.NewRegistry(), nil)
+	mempool, err := mempool.New("", gas.Dimensions{}, prometheus.NewRegistry(), nil)
 	require.NoError(err)
 
 	var (
@@ -454,7 +454,7 @@ func TestVerifierVisitCommitBlock(t *testing.T) {
 
 	// Create mocked dependencies.
 	s := state.NewMockState(ctrl)
-	mempool, err := mempool.New("", prometheus.NewRegistry(), nil)
+	mempool, err := mempool.New("", gas.Dimensions{}, prometheus.NewRegistry(), nil)
 	require.NoError(err)
 	parentID := ids.GenerateTestID()
 	parentStatelessBlk := block.NewMockBlock(ctrl)
@@ -528,7 +528,7 @@ func TestVerifierVisitAbortBlock(t *testing.T) {
 
 	// Create mocked dependencies.
 	s := state.NewMockState(ctrl)
-	mempool, err := mempool.New("", prometheus.NewRegistry(), nil)
+	mempool, err := mempool.New("", gas.Dimensions{}, prometheus.NewRegistry(), nil)
 	require.NoError(err)
 	parentID := ids.GenerateTestID()
 	parentStatelessBlk := block.NewMockBlock(ctrl)
@@ -603,7 +603,7 @@ func TestVerifyUnverifiedParent(t *testing.T) {
 
 	// Create mocked dependencies.
 	s := state.NewMockState(ctrl)
-	mempool, err := mempool.New("", prometheus.NewRegistry(), nil)
+	mempool, err := mempool.New("", gas.Dimensions{}, prometheus.NewRegistry(), nil)
 	require.NoError(err)
 	parentID := ids.GenerateTestID()
 
@@ -674,7 +674,7 @@ func TestBanffAbortBlockTimestampChecks(t *testing.T) {
 
 			// Create mocked dependencies.
 			s := state.NewMockState(ctrl)
-			mempool, err := mempool.New("", prometheus.NewRegistry(), nil)
+			mempool, err := mempool.New("", gas.Dimensions{}, prometheus.NewRegistry(), nil)
 			require.NoError(err)
 			parentID := ids.GenerateTestID()
 			parentStatelessBlk := block.NewMockBlock(ctrl)
@@ -775,7 +775,7 @@ func TestBanffCommitBlockTimestampChecks(t *testing.T) {
 
 			// Create mocked dependencies.
 			s := state.NewMockState(ctrl)
-			mempool, err := mempool.New("", prometheus.NewRegistry(), nil)
+			mempool, err := mempool.New("", gas.Dimensions{}, prometheus.NewRegistry(), nil)
 			require.NoError(err)
 			parentID := ids.GenerateTestID()
 			parentStatelessBlk := block.NewMockBlock(ctrl)
@@ -844,7 +844,7 @@ func TestVerifierVisitApricotStandardBlockWithProposalBlockParent(t *testing.T)
 
 	// Create mocked dependencies.
 	s := state.NewMockState(ctrl)
-	mempool, err := mempool.New("", prometheus.NewRegistry(), nil)
+	mempool, err := mempool.New("", gas.Dimensions{}, prometheus.NewRegistry(), nil)
 	require.NoError(err)
 	parentID := ids.GenerateTestID()
 	parentStatelessBlk := block.NewMockBlock(ctrl)
@@ -901,7 +901,7 @@ func TestVerifierVisitBanffStandardBlockWithProposalBlockParent(t *testing.T) {
 
 	// Create mocked dependencies.
 	s := state.NewMockState(ctrl)
-	mempool, err := mempool.New("", prometheus.NewRegistry(), nil)
+	mempool, err := mempool.New("", gas.Dimensions{}, prometheus.NewRegistry(), nil)
 	require.NoError(err)
 	parentID := ids.GenerateTestID()
 	parentStatelessBlk := block.NewMockBlock(ctrl)
@@ -110,6 +110,34 @@ func (mr *MockBlockMockRecorder) Parent() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Parent", reflect.TypeOf((*MockBlock)(nil).Parent))
 }
 
+// Size mocks base method.
+func (m *MockBlock) Size() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Size")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// Size indicates an expected call of Size.
+func (mr *MockBlockMockRecorder) Size() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Size", reflect.TypeOf((*MockBlock)(nil).Size))
+}
+
+// TxIDs mocks base method.
+func (m *MockBlock) TxIDs() []ids.ID {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxIDs")
+	ret0, _ := ret[0].([]ids.ID)
+	return ret0
+}
+
+// TxIDs indicates an expected call of TxIDs.
+func (mr *MockBlockMockRecorder) TxIDs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxIDs", reflect.TypeOf((*MockBlock)(nil).TxIDs))
+}
+
 // Txs mocks base method.
 func (m *MockBlock) Txs() []*txs.Tx {
 	m.ctrl.T.Helper()
@@ -54,6 +54,10 @@ func (b *BanffProposalBlock) Txs() []*txs.Tx {
 	return txs
 }
 
+func (b *BanffProposalBlock) TxIDs() []ids.ID {
+	return txIDs(b.Txs())
+}
+
 func (b *BanffProposalBlock) Visit(v Visitor) error {
 	return v.BanffProposalBlock(b)
 }
@@ -100,6 +104,10 @@ func (b *ApricotProposalBlock) Txs() []*txs.Tx {
 	return []*txs.Tx{b.Tx}
 }
 
+func (b *ApricotProposalBlock) TxIDs() []ids.ID {
+	return []ids.ID{b.Tx.ID()}
+}
+
 func (b *ApricotProposalBlock) Visit(v Visitor) error {
 	return v.ApricotProposalBlock(b)
 }
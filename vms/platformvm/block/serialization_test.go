@@ -116,6 +116,9 @@ func TestBanffBlockSerialization(t *testing.T) {
 			got, err := Codec.Marshal(CodecVersion, &block)
 			require.NoError(err)
 			require.Equal(test.bytes, got)
+
+			require.NoError(block.initialize(got))
+			require.Equal(len(test.bytes), block.Size())
 		})
 	}
 }
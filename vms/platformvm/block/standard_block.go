@@ -74,6 +74,10 @@ func (b *ApricotStandardBlock) Txs() []*txs.Tx {
 	return b.Transactions
 }
 
+func (b *ApricotStandardBlock) TxIDs() []ids.ID {
+	return txIDs(b.Transactions)
+}
+
 func (b *ApricotStandardBlock) Visit(v Visitor) error {
 	return v.ApricotStandardBlock(b)
 }
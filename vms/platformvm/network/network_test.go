@@ -18,6 +18,7 @@ import (
 	"github.com/ava-labs/avalanchego/snow/engine/common/commonmock"
 	"github.com/ava-labs/avalanchego/snow/snowtest"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
 	"github.com/ava-labs/avalanchego/vms/platformvm/config"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/txs/mempool"
@@ -72,7 +73,7 @@ func TestNetworkIssueTxFromRPC(t *testing.T) {
 		{
 			name: "mempool has transaction",
 			mempool: func() pmempool.Mempool {
-				mempool, err := pmempool.New("", prometheus.NewRegistry(), nil)
+				mempool, err := pmempool.New("", gas.Dimensions{}, prometheus.NewRegistry(), nil)
 				require.NoError(t, err)
 				require.NoError(t, mempool.Add(&txs.Tx{Unsigned: &txs.BaseTx{}}))
 				return mempool
@@ -86,7 +87,7 @@ func TestNetworkIssueTxFromRPC(t *testing.T) {
 		{
 			name: "transaction marked as dropped in mempool",
 			mempool: func() pmempool.Mempool {
-				mempool, err := pmempool.New("", prometheus.NewRegistry(), nil)
+				mempool, err := pmempool.New("", gas.Dimensions{}, prometheus.NewRegistry(), nil)
 				require.NoError(t, err)
 				mempool.MarkDropped(ids.Empty, errTest)
 				return mempool
@@ -101,7 +102,7 @@ func TestNetworkIssueTxFromRPC(t *testing.T) {
 		{
 			name: "tx dropped",
 			mempool: func() pmempool.Mempool {
-				mempool, err := pmempool.New("", prometheus.NewRegistry(), nil)
+				mempool, err := pmempool.New("", gas.Dimensions{}, prometheus.NewRegistry(), nil)
 				require.NoError(t, err)
 				return mempool
 			}(),
@@ -116,7 +117,7 @@ func TestNetworkIssueTxFromRPC(t *testing.T) {
 		{
 			name: "tx too big",
 			mempool: func() pmempool.Mempool {
-				mempool, err := pmempool.New("", prometheus.NewRegistry(), nil)
+				mempool, err := pmempool.New("", gas.Dimensions{}, prometheus.NewRegistry(), nil)
 				require.NoError(t, err)
 				return mempool
 			}(),
@@ -135,7 +136,7 @@ func TestNetworkIssueTxFromRPC(t *testing.T) {
 		{
 			name: "tx conflicts",
 			mempool: func() pmempool.Mempool {
-				mempool, err := pmempool.New("", prometheus.NewRegistry(), nil)
+				mempool, err := pmempool.New("", gas.Dimensions{}, prometheus.NewRegistry(), nil)
 				require.NoError(t, err)
 
 				tx := &txs.Tx{
@@ -177,7 +178,7 @@ func TestNetworkIssueTxFromRPC(t *testing.T) {
 		{
 			name: "mempool full",
 			mempool: func() pmempool.Mempool {
-				m, err := pmempool.New("", prometheus.NewRegistry(), nil)
+				m, err := pmempool.New("", gas.Dimensions{}, prometheus.NewRegistry(), nil)
 				require.NoError(t, err)
 
 				for i := 0; i < 1024; i++ {
@@ -204,7 +205,7 @@ func TestNetworkIssueTxFromRPC(t *testing.T) {
 		{
 			name: "happy path",
 			mempool: func() pmempool.Mempool {
-				mempool, err := pmempool.New("", prometheus.NewRegistry(), nil)
+				mempool, err := pmempool.New("", gas.Dimensions{}, prometheus.NewRegistry(), nil)
 				require.NoError(t, err)
 				return mempool
 			}(),
@@ -65,6 +65,7 @@ var (
 	errPrimaryNetworkIsNotASubnet = errors.New("the primary network isn't a subnet")
 	errNoAddresses                = errors.New("no addresses provided")
 	errMissingBlockchainID        = errors.New("argument 'blockchainID' not given")
+	errTxNotPending               = errors.New("tx is not pending")
 )
 
 // Service defines the API calls that can be made to the platform chain
@@ -1450,16 +1451,45 @@ func (s *Service) GetTx(_ *http.Request, args *api.GetTxArgs, response *api.GetT
 	if err != nil {
 		return fmt.Errorf("couldn't get tx: %w", err)
 	}
-	response.Encoding = args.Encoding
+	return s.formatTx(tx, args.Encoding, response)
+}
 
-	var result any
-	if args.Encoding == formatting.JSON {
+// GetPendingTx returns a tx that was issued but hasn't been accepted yet. Txs
+// in the preferred chain of processing blocks and in the mempool are returned.
+// Accepted txs are returned by GetTx.
+func (s *Service) GetPendingTx(_ *http.Request, args *api.GetTxArgs, response *api.GetTxReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getPendingTx"),
+	)
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	tx, err := s.getPendingTx(args.TxID)
+	if err != nil {
+		return fmt.Errorf("couldn't get pending tx: %w", err)
+	}
+	return s.formatTx(tx, args.Encoding, response)
+}
+
+// formatTx populates [response] with [tx] in the provided [encoding].
+//
+// Assumes the context lock is held.
+func (s *Service) formatTx(tx *txs.Tx, encoding formatting.Encoding, response *api.GetTxReply) error {
+	response.Encoding = encoding
+
+	var (
+		result any
+		err    error
+	)
+	if encoding == formatting.JSON {
 		tx.Unsigned.InitCtx(s.vm.ctx)
 		result = tx
 	} else {
-		result, err = formatting.Encode(args.Encoding, tx.Bytes())
+		result, err = formatting.Encode(encoding, tx.Bytes())
 		if err != nil {
-			return fmt.Errorf("couldn't encode tx as %s: %w", args.Encoding, err)
+			return fmt.Errorf("couldn't encode tx as %s: %w", encoding, err)
 		}
 	}
 
@@ -1467,6 +1497,36 @@ func (s *Service) GetTx(_ *http.Request, args *api.GetTxArgs, response *api.GetT
 	return err
 }
 
+// getPendingTx returns the tx with the provided ID if it is in the preferred
+// block's state or the mempool but hasn't been accepted.
+//
+// Assumes the context lock is held.
+func (s *Service) getPendingTx(txID ids.ID) (*txs.Tx, error) {
+	_, _, err := s.vm.state.GetTx(txID)
+	if err == nil {
+		return nil, fmt.Errorf("%w: %s was accepted", errTxNotPending, txID)
+	}
+	if err != database.ErrNotFound {
+		return nil, err
+	}
+
+	preferredID := s.vm.manager.Preferred()
+	onAccept, ok := s.vm.manager.GetState(preferredID)
+	if !ok {
+		return nil, fmt.Errorf("could not retrieve state for block %s", preferredID)
+	}
+
+	tx, _, err := onAccept.GetTx(txID)
+	if err != database.ErrNotFound {
+		return tx, err
+	}
+
+	if tx, ok := s.vm.Builder.Get(txID); ok {
+		return tx, nil
+	}
+	return nil, database.ErrNotFound
+}
+
 type GetTxStatusArgs struct {
 	TxID ids.ID `json:"txID"`
 }
@@ -388,6 +388,75 @@ func TestGetTx(t *testing.T) {
 	}
 }
 
+func TestGetPendingTx(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+
+	service.vm.ctx.Lock.Lock()
+	wallet := newWallet(t, service.vm, walletConfig{})
+	tx, err := wallet.IssueExportTx(
+		service.vm.ctx.XChainID,
+		[]*avax.TransferableOutput{{
+			Asset: avax.Asset{ID: service.vm.ctx.AVAXAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: 100,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+				},
+			},
+		}},
+	)
+	require.NoError(err)
+	service.vm.ctx.Lock.Unlock()
+
+	var (
+		arg = &api.GetTxArgs{
+			TxID:     tx.ID(),
+			Encoding: formatting.Hex,
+		}
+		response api.GetTxReply
+	)
+	requirePendingTx := func() {
+		require.NoError(service.GetPendingTx(nil, arg, &response))
+
+		var txStr string
+		require.NoError(json.Unmarshal(response.Tx, &txStr))
+		txBytes, err := formatting.Decode(response.Encoding, txStr)
+		require.NoError(err)
+		require.Equal(tx.Bytes(), txBytes)
+	}
+
+	// The tx hasn't been issued yet
+	err = service.GetPendingTx(nil, arg, &response)
+	require.ErrorIs(err, database.ErrNotFound)
+
+	// The tx is in the mempool
+	require.NoError(service.vm.Network.IssueTxFromRPC(tx))
+	requirePendingTx()
+
+	err = service.GetTx(nil, arg, &response)
+	require.ErrorIs(err, database.ErrNotFound)
+
+	// The tx is in a processing block
+	service.vm.ctx.Lock.Lock()
+	blk, err := service.vm.BuildBlock(context.Background())
+	require.NoError(err)
+	require.NoError(blk.Verify(context.Background()))
+	require.NoError(service.vm.SetPreference(context.Background(), blk.ID()))
+	service.vm.ctx.Lock.Unlock()
+	requirePendingTx()
+
+	// The tx was accepted
+	service.vm.ctx.Lock.Lock()
+	require.NoError(blk.Accept(context.Background()))
+	service.vm.ctx.Lock.Unlock()
+
+	err = service.GetPendingTx(nil, arg, &response)
+	require.ErrorIs(err, errTxNotPending)
+	require.NoError(service.GetTx(nil, arg, &response))
+}
+
 func TestGetBalance(t *testing.T) {
 	require := require.New(t)
 	service, _ := defaultService(t)
@@ -26,6 +26,19 @@ func (s *LockOut) Addresses() [][]byte {
 	return nil
 }
 
+func (s *LockOut) InnerOutput() avax.TransferableOut {
+	return s.TransferableOut
+}
+
+// UnlockTime returns the later of the stakeable locktime and the unlock time of
+// the wrapped output.
+func (s *LockOut) UnlockTime() uint64 {
+	if timed, ok := s.TransferableOut.(avax.TimedOutput); ok {
+		return max(s.Locktime, timed.UnlockTime())
+	}
+	return s.Locktime
+}
+
 func (s *LockOut) Verify() error {
 	if s.Locktime == 0 {
 		return errInvalidLocktime
@@ -10,8 +10,10 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/avax/avaxmock"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 )
 
 var errTest = errors.New("hi mom")
@@ -131,3 +133,65 @@ func TestLockInVerify(t *testing.T) {
 		})
 	}
 }
+
+func TestLockOutUnlockTime(t *testing.T) {
+	tests := []struct {
+		name               string
+		locktime           uint64
+		transferableOut    avax.TransferableOut
+		expectedUnlockTime uint64
+	}{
+		{
+			name:     "stakeable locktime later",
+			locktime: 2,
+			transferableOut: &secp256k1fx.TransferOutput{
+				OutputOwners: secp256k1fx.OutputOwners{Locktime: 1},
+			},
+			expectedUnlockTime: 2,
+		},
+		{
+			name:     "output locktime later",
+			locktime: 1,
+			transferableOut: &secp256k1fx.TransferOutput{
+				OutputOwners: secp256k1fx.OutputOwners{Locktime: 2},
+			},
+			expectedUnlockTime: 2,
+		},
+		{
+			name:               "output not timelocked",
+			locktime:           1,
+			transferableOut:    &avax.TestTransferable{Val: 1},
+			expectedUnlockTime: 1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			lockOut := &LockOut{
+				Locktime:        test.locktime,
+				TransferableOut: test.transferableOut,
+			}
+			require.Equal(t, test.expectedUnlockTime, lockOut.UnlockTime())
+		})
+	}
+}
+
+func TestLockOutOwners(t *testing.T) {
+	require := require.New(t)
+
+	owners := secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+	}
+	utxo := &avax.UTXO{
+		Out: &LockOut{
+			Locktime: 1,
+			TransferableOut: &secp256k1fx.TransferOutput{
+				Amt:          1,
+				OutputOwners: owners,
+			},
+		},
+	}
+	utxoOwners, err := utxo.Owners()
+	require.NoError(err)
+	require.Equal([]*secp256k1fx.OutputOwners{&owners}, utxoOwners)
+}
@@ -498,6 +498,16 @@ func blockSize(_ ids.ID, blk block.Block) int {
 	return ids.IDLen + len(blk.Bytes()) + constants.PointerOverhead
 }
 
+// NewAcceptedUTXOGetter returns a UTXOGetter that reads the UTXOs of the state
+// committed to [db]. Unlike State, it is safe to use concurrently with the
+// state being modified. UTXOs aren't cached, so every call reads from [db].
+//
+// UTXOs that haven't been committed, such as the UTXOs produced by processing
+// blocks, aren't returned.
+func NewAcceptedUTXOGetter(db database.Database) avax.UTXOGetter {
+	return avax.NewUncachedUTXOGetter(prefixdb.New(UTXOPrefix, db), txs.GenesisCodec)
+}
+
 func New(
 	db database.Database,
 	genesisBytes []byte,
@@ -2147,7 +2157,7 @@ func (s *state) init(genesisBytes []byte) error {
 	// genesisBlock.Accept() because then it'd look for genesisBlock's
 	// non-existent parent)
 	genesisID := hashing.ComputeHash256Array(genesisBytes)
-	genesisBlock, err := block.NewApricotCommitBlock(genesisID, 0 /*height*/)
+	genesisBlock, err := block.NewApricotGenesisBlock(genesisID)
 	if err != nil {
 		return err
 	}
@@ -2272,7 +2282,7 @@ func (s *state) GetBlockIDAtHeight(height uint64) (ids.ID, error) {
 		return blkID, nil
 	}
 	if blkID, cached := s.blockIDCache.Get(height); cached {
-		if blkID == ids.Empty {
+		if blkID.IsZero() {
 			return ids.Empty, database.ErrNotFound
 		}
 
@@ -11,6 +11,7 @@ import (
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
 	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/units"
@@ -450,3 +451,42 @@ func TestBaseTxSerialization(t *testing.T) {
 	"memo": "0xf09f98850a77656c6c2074686174277301234521"
 }`, string(unsignedComplexBaseTxJSONBytes))
 }
+
+func TestBaseTxSyntacticVerifyMemo(t *testing.T) {
+	ctx := snowtest.Context(t, snowtest.PChainID)
+
+	tests := []struct {
+		name        string
+		memoLen     int
+		expectedErr error
+	}{
+		{
+			name:        "empty memo",
+			memoLen:     0,
+			expectedErr: nil,
+		},
+		{
+			name:        "memo at limit",
+			memoLen:     avax.MaxMemoSize,
+			expectedErr: nil,
+		},
+		{
+			name:        "memo too long",
+			memoLen:     avax.MaxMemoSize + 1,
+			expectedErr: avax.ErrMemoTooLarge,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tx := &BaseTx{
+				BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Memo:         make([]byte, test.memoLen),
+				},
+			}
+			err := tx.SyntacticVerify(ctx)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
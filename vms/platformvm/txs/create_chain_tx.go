@@ -60,7 +60,7 @@ func (tx *CreateChainTx) SyntacticVerify(ctx *snow.Context) error {
 		return ErrCantValidatePrimaryNetwork
 	case len(tx.ChainName) > MaxNameLen:
 		return errNameTooLong
-	case tx.VMID == ids.Empty:
+	case tx.VMID.IsZero():
 		return errInvalidVMID
 	case !utils.IsSortedAndUnique(tx.FxIDs):
 		return errFxIDsNotSortedAndUnique
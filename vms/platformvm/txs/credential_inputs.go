@@ -0,0 +1,160 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+var _ Visitor = (*credentialInputs)(nil)
+
+// credentialInput is the input authorized by a credential of a tx.
+type credentialInput struct {
+	// input is nil if the credential doesn't authorize a secp256k1fx input.
+	input *secp256k1fx.Input
+	// utxoID is nil if the credential doesn't authorize spending a UTXO in the
+	// P-chain state, such as for imported inputs and subnet authorizations.
+	utxoID *avax.UTXOID
+}
+
+// credentialInputs collects the inputs authorized by the credentials of a tx,
+// in the order of the credentials.
+type credentialInputs struct {
+	inputs []credentialInput
+}
+
+func (c *credentialInputs) addIns(ins []*avax.TransferableInput) {
+	for _, in := range ins {
+		c.inputs = append(c.inputs, credentialInput{
+			input:  transferInput(in.In),
+			utxoID: &in.UTXOID,
+		})
+	}
+}
+
+func (c *credentialInputs) addImportedIns(ins []*avax.TransferableInput) {
+	for _, in := range ins {
+		c.inputs = append(c.inputs, credentialInput{
+			input: transferInput(in.In),
+		})
+	}
+}
+
+func (c *credentialInputs) addAuth(auth verify.Verifiable) {
+	input, _ := auth.(*secp256k1fx.Input)
+	c.inputs = append(c.inputs, credentialInput{
+		input: input,
+	})
+}
+
+func transferInput(in avax.TransferableIn) *secp256k1fx.Input {
+	switch in := in.(type) {
+	case *secp256k1fx.TransferInput:
+		return &in.Input
+	case *stakeable.LockIn:
+		return transferInput(in.TransferableIn)
+	default:
+		return nil
+	}
+}
+
+func (c *credentialInputs) AddValidatorTx(tx *AddValidatorTx) error {
+	return c.BaseTx(&tx.BaseTx)
+}
+
+func (c *credentialInputs) AddSubnetValidatorTx(tx *AddSubnetValidatorTx) error {
+	c.addIns(tx.Ins)
+	c.addAuth(tx.SubnetAuth)
+	return nil
+}
+
+func (c *credentialInputs) AddDelegatorTx(tx *AddDelegatorTx) error {
+	return c.BaseTx(&tx.BaseTx)
+}
+
+func (c *credentialInputs) CreateChainTx(tx *CreateChainTx) error {
+	c.addIns(tx.Ins)
+	c.addAuth(tx.SubnetAuth)
+	return nil
+}
+
+func (c *credentialInputs) CreateSubnetTx(tx *CreateSubnetTx) error {
+	return c.BaseTx(&tx.BaseTx)
+}
+
+func (c *credentialInputs) ImportTx(tx *ImportTx) error {
+	c.addIns(tx.Ins)
+	c.addImportedIns(tx.ImportedInputs)
+	return nil
+}
+
+func (c *credentialInputs) ExportTx(tx *ExportTx) error {
+	return c.BaseTx(&tx.BaseTx)
+}
+
+func (*credentialInputs) AdvanceTimeTx(*AdvanceTimeTx) error {
+	return nil
+}
+
+func (*credentialInputs) RewardValidatorTx(*RewardValidatorTx) error {
+	return nil
+}
+
+func (c *credentialInputs) RemoveSubnetValidatorTx(tx *RemoveSubnetValidatorTx) error {
+	c.addIns(tx.Ins)
+	c.addAuth(tx.SubnetAuth)
+	return nil
+}
+
+func (c *credentialInputs) TransformSubnetTx(tx *TransformSubnetTx) error {
+	c.addIns(tx.Ins)
+	c.addAuth(tx.SubnetAuth)
+	return nil
+}
+
+func (c *credentialInputs) AddPermissionlessValidatorTx(tx *AddPermissionlessValidatorTx) error {
+	return c.BaseTx(&tx.BaseTx)
+}
+
+func (c *credentialInputs) AddPermissionlessDelegatorTx(tx *AddPermissionlessDelegatorTx) error {
+	return c.BaseTx(&tx.BaseTx)
+}
+
+func (c *credentialInputs) TransferSubnetOwnershipTx(tx *TransferSubnetOwnershipTx) error {
+	c.addIns(tx.Ins)
+	c.addAuth(tx.SubnetAuth)
+	return nil
+}
+
+func (c *credentialInputs) BaseTx(tx *BaseTx) error {
+	c.addIns(tx.Ins)
+	return nil
+}
+
+func (c *credentialInputs) ConvertSubnetToL1Tx(tx *ConvertSubnetToL1Tx) error {
+	c.addIns(tx.Ins)
+	c.addAuth(tx.SubnetAuth)
+	return nil
+}
+
+func (c *credentialInputs) RegisterL1ValidatorTx(tx *RegisterL1ValidatorTx) error {
+	return c.BaseTx(&tx.BaseTx)
+}
+
+func (c *credentialInputs) SetL1ValidatorWeightTx(tx *SetL1ValidatorWeightTx) error {
+	return c.BaseTx(&tx.BaseTx)
+}
+
+func (c *credentialInputs) IncreaseL1ValidatorBalanceTx(tx *IncreaseL1ValidatorBalanceTx) error {
+	return c.BaseTx(&tx.BaseTx)
+}
+
+func (c *credentialInputs) DisableL1ValidatorTx(tx *DisableL1ValidatorTx) error {
+	c.addIns(tx.Ins)
+	c.addAuth(tx.DisableAuth)
+	return nil
+}
@@ -4,6 +4,7 @@
 package executor
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/ava-labs/avalanchego/chains/atomic"
@@ -22,6 +23,7 @@ var _ txs.Visitor = (*atomicTxExecutor)(nil)
 // This is only used to execute atomic transactions pre-AP5. After AP5 the
 // execution was moved to [StandardTx].
 func AtomicTx(
+	ctx context.Context,
 	backend *Backend,
 	feeCalculator fee.Calculator,
 	parentID ids.ID,
@@ -29,6 +31,7 @@ func AtomicTx(
 	tx *txs.Tx,
 ) (state.Diff, set.Set[ids.ID], map[ids.ID]*atomic.Requests, error) {
 	atomicExecutor := atomicTxExecutor{
+		ctx:           ctx,
 		backend:       backend,
 		feeCalculator: feeCalculator,
 		parentID:      parentID,
@@ -44,6 +47,7 @@ func AtomicTx(
 
 type atomicTxExecutor struct {
 	// inputs, to be filled before visitor methods are called
+	ctx           context.Context
 	backend       *Backend
 	feeCalculator fee.Calculator
 	parentID      ids.ID
@@ -147,6 +151,7 @@ func (e *atomicTxExecutor) atomicTx() error {
 
 	e.onAccept = onAccept
 	e.inputs, e.atomicRequests, _, err = StandardTx(
+		e.ctx,
 		e.backend,
 		e.feeCalculator,
 		e.tx,
@@ -4,6 +4,7 @@
 package executor
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -51,6 +52,7 @@ func TestCreateChainTxInsufficientControlSigs(t *testing.T) {
 
 	feeCalculator := state.PickFeeCalculator(env.config, stateDiff)
 	_, _, _, err = StandardTx(
+		context.Background(),
 		&env.backend,
 		feeCalculator,
 		tx,
@@ -94,6 +96,7 @@ func TestCreateChainTxWrongControlSig(t *testing.T) {
 
 	feeCalculator := state.PickFeeCalculator(env.config, stateDiff)
 	_, _, _, err = StandardTx(
+		context.Background(),
 		&env.backend,
 		feeCalculator,
 		tx,
@@ -134,6 +137,7 @@ func TestCreateChainTxNoSuchSubnet(t *testing.T) {
 
 	feeCalculator := state.PickFeeCalculator(env.config, builderDiff)
 	_, _, _, err = StandardTx(
+		context.Background(),
 		&env.backend,
 		feeCalculator,
 		tx,
@@ -171,6 +175,7 @@ func TestCreateChainTxValid(t *testing.T) {
 
 	feeCalculator := state.PickFeeCalculator(env.config, builderDiff)
 	_, _, _, err = StandardTx(
+		context.Background(),
 		&env.backend,
 		feeCalculator,
 		tx,
@@ -237,6 +242,7 @@ func TestCreateChainTxAP3FeeChange(t *testing.T) {
 
 			feeCalculator := state.PickFeeCalculator(env.config, stateDiff)
 			_, _, _, err = StandardTx(
+				context.Background(),
 				&env.backend,
 				feeCalculator,
 				tx,
@@ -284,6 +290,7 @@ func TestEtnaCreateChainTxInvalidWithManagedSubnet(t *testing.T) {
 
 	feeCalculator := state.PickFeeCalculator(env.config, builderDiff)
 	_, _, _, err = StandardTx(
+		context.Background(),
 		&env.backend,
 		feeCalculator,
 		tx,
@@ -4,6 +4,7 @@
 package executor
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -67,6 +68,7 @@ func TestNewExportTx(t *testing.T) {
 
 			feeCalculator := state.PickFeeCalculator(env.config, stateDiff)
 			_, _, _, err = StandardTx(
+				context.Background(),
 				&env.backend,
 				feeCalculator,
 				tx,
@@ -4,6 +4,7 @@
 package executor
 
 import (
+	"context"
 	"math"
 	"testing"
 	"time"
@@ -221,6 +222,7 @@ func addSubnet(t *testing.T, env *environment) {
 
 	feeCalculator := state.PickFeeCalculator(env.config, env.state)
 	_, _, _, err = StandardTx(
+		context.Background(),
 		&env.backend,
 		feeCalculator,
 		testSubnet1,
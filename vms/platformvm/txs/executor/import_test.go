@@ -4,6 +4,7 @@
 package executor
 
 import (
+	"context"
 	"math/rand"
 	"testing"
 	"time"
@@ -154,6 +155,7 @@ func TestNewImportTx(t *testing.T) {
 
 			feeCalculator := state.PickFeeCalculator(env.config, stateDiff)
 			_, _, _, err = StandardTx(
+				context.Background(),
 				&env.backend,
 				feeCalculator,
 				tx,
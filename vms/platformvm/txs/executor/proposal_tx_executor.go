@@ -9,7 +9,6 @@ import (
 	"time"
 
 	"github.com/ava-labs/avalanchego/database"
-	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/math"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/verify"
@@ -332,7 +331,7 @@ func (e *proposalTxExecutor) RewardValidatorTx(tx *txs.RewardValidatorTx) error
 	switch {
 	case tx == nil:
 		return txs.ErrNilTx
-	case tx.TxID == ids.Empty:
+	case tx.TxID.IsZero():
 		return ErrInvalidID
 	case len(e.tx.Creds) != 0:
 		return errWrongNumberOfCredentials
@@ -8,12 +8,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
+	"strconv"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 
 	"github.com/ava-labs/avalanchego/chains/atomic"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls"
 	"github.com/ava-labs/avalanchego/utils/math"
@@ -29,6 +33,8 @@ import (
 	"github.com/ava-labs/avalanchego/vms/platformvm/warp/message"
 	"github.com/ava-labs/avalanchego/vms/platformvm/warp/payload"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+
+	oteltrace "go.opentelemetry.io/otel/trace"
 )
 
 // TODO: Before Etna, ensure that the maximum number of expiries to track is
@@ -74,6 +80,7 @@ var (
 //   - A, potentially nil, function that should be called when this transaction
 //     is accepted.
 func StandardTx(
+	ctx context.Context,
 	backend *Backend,
 	feeCalculator fee.Calculator,
 	tx *txs.Tx,
@@ -85,6 +92,14 @@ func StandardTx(
 		tx:            tx,
 		state:         state,
 	}
+	if backend.Tracer != nil {
+		_, span := backend.Tracer.Start(
+			ctx,
+			txSpanName(tx.Unsigned),
+			oteltrace.WithAttributes(txSpanAttributes(tx)...),
+		)
+		defer span.End()
+	}
 	if err := tx.Unsigned.Visit(&standardExecutor); err != nil {
 		txID := tx.ID()
 		return nil, nil, nil, fmt.Errorf("standard tx %s failed execution: %w", txID, err)
@@ -92,6 +107,35 @@ func StandardTx(
 	return standardExecutor.inputs, standardExecutor.atomicRequests, standardExecutor.onAccept, nil
 }
 
+// txSpanName returns the name of the span tracing the execution of [utx], of
+// the form platformvm.tx.{txType}.
+func txSpanName(utx txs.UnsignedTx) string {
+	return "platformvm.tx." + reflect.TypeOf(utx).Elem().Name()
+}
+
+// txSpanAttributes returns the ID, consumed UTXO IDs, and output amounts of
+// [tx]. Output amounts are recorded as decimal strings because they may not fit
+// into an int64.
+func txSpanAttributes(tx *txs.Tx) []attribute.KeyValue {
+	inputIDs := tx.InputIDs().List()
+	utils.Sort(inputIDs)
+	inputIDStrs := make([]string, len(inputIDs))
+	for i, inputID := range inputIDs {
+		inputIDStrs[i] = inputID.String()
+	}
+
+	outs := tx.Unsigned.Outputs()
+	outputAmounts := make([]string, len(outs))
+	for i, out := range outs {
+		outputAmounts[i] = strconv.FormatUint(out.Out.Amount(), 10)
+	}
+	return []attribute.KeyValue{
+		attribute.Stringer("txID", tx.ID()),
+		attribute.StringSlice("inputIDs", inputIDStrs),
+		attribute.StringSlice("outputAmounts", outputAmounts),
+	}
+}
+
 type standardTxExecutor struct {
 	// inputs, to be filled before visitor methods are called
 	backend       *Backend
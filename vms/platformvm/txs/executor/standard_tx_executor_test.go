@@ -4,13 +4,17 @@
 package executor
 
 import (
+	"context"
 	"errors"
 	"math"
 	"math/rand"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"go.uber.org/mock/gomock"
 
 	"github.com/ava-labs/avalanchego/codec"
@@ -47,11 +51,14 @@ import (
 	"github.com/ava-labs/avalanchego/vms/platformvm/warp/message"
 	"github.com/ava-labs/avalanchego/vms/platformvm/warp/payload"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/chain/p/wallet"
 	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
 
 	safemath "github.com/ava-labs/avalanchego/utils/math"
 	txfee "github.com/ava-labs/avalanchego/vms/platformvm/txs/fee"
 	validatorfee "github.com/ava-labs/avalanchego/vms/platformvm/validators/fee"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	oteltrace "go.opentelemetry.io/otel/trace"
 )
 
 // This tests that the math performed during TransformSubnetTx execution can
@@ -108,6 +115,7 @@ func TestStandardTxExecutorAddValidatorTxEmptyID(t *testing.T) {
 
 		feeCalculator := state.PickFeeCalculator(env.config, stateDiff)
 		_, _, _, err = StandardTx(
+			context.Background(),
 			&env.backend,
 			feeCalculator,
 			tx,
@@ -359,6 +367,7 @@ func TestStandardTxExecutorAddDelegator(t *testing.T) {
 
 			feeCalculator := state.PickFeeCalculator(env.config, onAcceptState)
 			_, _, _, err = StandardTx(
+				context.Background(),
 				&env.backend,
 				feeCalculator,
 				tx,
@@ -405,6 +414,7 @@ func TestApricotStandardTxExecutorAddSubnetValidator(t *testing.T) {
 
 		feeCalculator := state.PickFeeCalculator(env.config, onAcceptState)
 		_, _, _, err = StandardTx(
+			context.Background(),
 			&env.backend,
 			feeCalculator,
 			tx,
@@ -439,6 +449,7 @@ func TestApricotStandardTxExecutorAddSubnetValidator(t *testing.T) {
 
 		feeCalculator := state.PickFeeCalculator(env.config, onAcceptState)
 		_, _, _, err = StandardTx(
+			context.Background(),
 			&env.backend,
 			feeCalculator,
 			tx,
@@ -492,6 +503,7 @@ func TestApricotStandardTxExecutorAddSubnetValidator(t *testing.T) {
 
 		feeCalculator := state.PickFeeCalculator(env.config, onAcceptState)
 		_, _, _, err = StandardTx(
+			context.Background(),
 			&env.backend,
 			feeCalculator,
 			tx,
@@ -541,6 +553,7 @@ func TestApricotStandardTxExecutorAddSubnetValidator(t *testing.T) {
 
 		feeCalculator := state.PickFeeCalculator(env.config, onAcceptState)
 		_, _, _, err = StandardTx(
+			context.Background(),
 			&env.backend,
 			feeCalculator,
 			tx,
@@ -573,6 +586,7 @@ func TestApricotStandardTxExecutorAddSubnetValidator(t *testing.T) {
 
 		feeCalculator := state.PickFeeCalculator(env.config, onAcceptState)
 		_, _, _, err = StandardTx(
+			context.Background(),
 			&env.backend,
 			feeCalculator,
 			tx,
@@ -605,6 +619,7 @@ func TestApricotStandardTxExecutorAddSubnetValidator(t *testing.T) {
 
 		feeCalculator := state.PickFeeCalculator(env.config, onAcceptState)
 		_, _, _, err = StandardTx(
+			context.Background(),
 			&env.backend,
 			feeCalculator,
 			tx,
@@ -640,6 +655,7 @@ func TestApricotStandardTxExecutorAddSubnetValidator(t *testing.T) {
 
 		feeCalculator := state.PickFeeCalculator(env.config, onAcceptState)
 		_, _, _, err = StandardTx(
+			context.Background(),
 			&env.backend,
 			feeCalculator,
 			tx,
@@ -707,6 +723,7 @@ func TestApricotStandardTxExecutorAddSubnetValidator(t *testing.T) {
 
 		feeCalculator := state.PickFeeCalculator(env.config, onAcceptState)
 		_, _, _, err = StandardTx(
+			context.Background(),
 			&env.backend,
 			feeCalculator,
 			tx,
@@ -750,6 +767,7 @@ func TestApricotStandardTxExecutorAddSubnetValidator(t *testing.T) {
 
 		feeCalculator := state.PickFeeCalculator(env.config, onAcceptState)
 		_, _, _, err = StandardTx(
+			context.Background(),
 			&env.backend,
 			feeCalculator,
 			tx,
@@ -789,6 +807,7 @@ func TestApricotStandardTxExecutorAddSubnetValidator(t *testing.T) {
 
 		feeCalculator := state.PickFeeCalculator(env.config, onAcceptState)
 		_, _, _, err = StandardTx(
+			context.Background(),
 			&env.backend,
 			feeCalculator,
 			tx,
@@ -826,6 +845,7 @@ func TestApricotStandardTxExecutorAddSubnetValidator(t *testing.T) {
 
 		feeCalculator := state.PickFeeCalculator(env.config, onAcceptState)
 		_, _, _, err = StandardTx(
+			context.Background(),
 			&env.backend,
 			feeCalculator,
 			tx,
@@ -873,6 +893,7 @@ func TestApricotStandardTxExecutorAddSubnetValidator(t *testing.T) {
 
 		feeCalculator := state.PickFeeCalculator(env.config, onAcceptState)
 		_, _, _, err = StandardTx(
+			context.Background(),
 			&env.backend,
 			feeCalculator,
 			tx,
@@ -921,6 +942,7 @@ func TestEtnaStandardTxExecutorAddSubnetValidator(t *testing.T) {
 
 	feeCalculator := state.PickFeeCalculator(env.config, onAcceptState)
 	_, _, _, err = StandardTx(
+		context.Background(),
 		&env.backend,
 		feeCalculator,
 		tx,
@@ -961,6 +983,7 @@ func TestBanffStandardTxExecutorAddValidator(t *testing.T) {
 
 		feeCalculator := state.PickFeeCalculator(env.config, onAcceptState)
 		_, _, _, err = StandardTx(
+			context.Background(),
 			&env.backend,
 			feeCalculator,
 			tx,
@@ -1003,6 +1026,7 @@ func TestBanffStandardTxExecutorAddValidator(t *testing.T) {
 
 		feeCalculator := state.PickFeeCalculator(env.config, onAcceptState)
 		_, _, _, err = StandardTx(
+			context.Background(),
 			&env.backend,
 			feeCalculator,
 			tx,
@@ -1042,6 +1066,7 @@ func TestBanffStandardTxExecutorAddValidator(t *testing.T) {
 
 		feeCalculator := state.PickFeeCalculator(env.config, onAcceptState)
 		_, _, _, err = StandardTx(
+			context.Background(),
 			&env.backend,
 			feeCalculator,
 			tx,
@@ -1082,6 +1107,7 @@ func TestBanffStandardTxExecutorAddValidator(t *testing.T) {
 
 		feeCalculator := state.PickFeeCalculator(env.config, onAcceptState)
 		_, _, _, err = StandardTx(
+			context.Background(),
 			&env.backend,
 			feeCalculator,
 			tx,
@@ -1181,6 +1207,7 @@ func TestDurangoDisabledTransactions(t *testing.T) {
 			tx := tt.buildTx(t, env)
 			feeCalculator := state.PickFeeCalculator(env.config, onAcceptState)
 			_, _, _, err = StandardTx(
+				context.Background(),
 				&env.backend,
 				feeCalculator,
 				tx,
@@ -1392,6 +1419,7 @@ func TestDurangoMemoField(t *testing.T) {
 
 				feeCalculator := state.PickFeeCalculator(env.config, onAcceptState)
 				_, _, _, err = StandardTx(
+					context.Background(),
 					&env.backend,
 					feeCalculator,
 					subnetValTx,
@@ -1586,6 +1614,7 @@ func TestDurangoMemoField(t *testing.T) {
 			// Populated memo field should error
 			tx, onAcceptState := tt.setupTest(t, env, []byte{'m', 'e', 'm', 'o'})
 			_, _, _, err := StandardTx(
+				context.Background(),
 				&env.backend,
 				feeCalculator,
 				tx,
@@ -1596,6 +1625,7 @@ func TestDurangoMemoField(t *testing.T) {
 			// Empty memo field should not error
 			tx, onAcceptState = tt.setupTest(t, env, []byte{})
 			_, _, _, err = StandardTx(
+				context.Background(),
 				&env.backend,
 				feeCalculator,
 				tx,
@@ -1622,6 +1652,7 @@ func TestEtnaDisabledTransactions(t *testing.T) {
 		Unsigned: &txs.TransformSubnetTx{},
 	}
 	_, _, _, err = StandardTx(
+		context.Background(),
 		&env.backend,
 		feeCalculator,
 		tx,
@@ -2406,6 +2437,7 @@ func TestStandardExecutorConvertSubnetToL1Tx(t *testing.T) {
 	require.NoError(t, err)
 
 	_, _, _, err = StandardTx(
+		context.Background(),
 		&Backend{
 			Config:       defaultConfig,
 			Bootstrapped: utils.NewAtomic(true),
@@ -2736,6 +2768,7 @@ func TestStandardExecutorRegisterL1ValidatorTx(t *testing.T) {
 
 	// Execute the subnet creation
 	_, _, _, err = StandardTx(
+		context.Background(),
 		backend,
 		feeCalculator,
 		createSubnetTx,
@@ -2779,6 +2812,7 @@ func TestStandardExecutorRegisterL1ValidatorTx(t *testing.T) {
 
 	// Execute the subnet conversion
 	_, _, _, err = StandardTx(
+		context.Background(),
 		backend,
 		feeCalculator,
 		convertSubnetToL1Tx,
@@ -3260,6 +3294,7 @@ func TestStandardExecutorSetL1ValidatorWeightTx(t *testing.T) {
 
 	// Execute the subnet creation
 	_, _, _, err = StandardTx(
+		context.Background(),
 		backend,
 		feeCalculator,
 		createSubnetTx,
@@ -3312,6 +3347,7 @@ func TestStandardExecutorSetL1ValidatorWeightTx(t *testing.T) {
 
 	// Execute the subnet conversion
 	_, _, _, err = StandardTx(
+		context.Background(),
 		backend,
 		feeCalculator,
 		convertSubnetToL1Tx,
@@ -3759,6 +3795,7 @@ func TestStandardExecutorIncreaseL1ValidatorBalanceTx(t *testing.T) {
 
 	// Execute the subnet creation
 	_, _, _, err = StandardTx(
+		context.Background(),
 		backend,
 		feeCalculator,
 		createSubnetTx,
@@ -3811,6 +3848,7 @@ func TestStandardExecutorIncreaseL1ValidatorBalanceTx(t *testing.T) {
 
 	// Execute the subnet conversion
 	_, _, _, err = StandardTx(
+		context.Background(),
 		backend,
 		feeCalculator,
 		convertSubnetToL1Tx,
@@ -4049,6 +4087,7 @@ func TestStandardExecutorDisableL1ValidatorTx(t *testing.T) {
 
 	// Execute the subnet creation
 	_, _, _, err = StandardTx(
+		context.Background(),
 		backend,
 		feeCalculator,
 		createSubnetTx,
@@ -4105,6 +4144,7 @@ func TestStandardExecutorDisableL1ValidatorTx(t *testing.T) {
 
 	// Execute the subnet conversion
 	_, _, _, err = StandardTx(
+		context.Background(),
 		backend,
 		feeCalculator,
 		convertSubnetToL1Tx,
@@ -4300,3 +4340,120 @@ func must[T any](t require.TestingT) func(T, error) T {
 		return val
 	}
 }
+
+type testTracer struct {
+	oteltrace.Tracer
+}
+
+func (*testTracer) Close() error {
+	return nil
+}
+
+func TestStandardTxTracing(t *testing.T) {
+	env := newEnvironment(t, upgradetest.Durango)
+	env.ctx.Lock.Lock()
+	defer env.ctx.Lock.Unlock()
+
+	owner := &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+	}
+	outputs := []*avax.TransferableOutput{{
+		Asset: avax.Asset{ID: env.ctx.AVAXAssetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt:          units.Avax,
+			OutputOwners: *owner,
+		},
+	}}
+
+	tests := []struct {
+		name             string
+		issueTx          func(wallet.Wallet) (*txs.Tx, error)
+		expectedSpanName string
+	}{
+		{
+			name: "BaseTx",
+			issueTx: func(w wallet.Wallet) (*txs.Tx, error) {
+				return w.IssueBaseTx(outputs)
+			},
+			expectedSpanName: "platformvm.tx.BaseTx",
+		},
+		{
+			name: "CreateSubnetTx",
+			issueTx: func(w wallet.Wallet) (*txs.Tx, error) {
+				return w.IssueCreateSubnetTx(owner)
+			},
+			expectedSpanName: "platformvm.tx.CreateSubnetTx",
+		},
+		{
+			name: "ExportTx",
+			issueTx: func(w wallet.Wallet) (*txs.Tx, error) {
+				return w.IssueExportTx(env.ctx.XChainID, outputs)
+			},
+			expectedSpanName: "platformvm.tx.ExportTx",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			var (
+				recorder = tracetest.NewSpanRecorder()
+				provider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+				backend  = env.backend
+			)
+			backend.Tracer = &testTracer{Tracer: provider.Tracer("test")}
+
+			tx, err := test.issueTx(newWallet(t, env, walletConfig{}))
+			require.NoError(err)
+
+			stateDiff, err := state.NewDiff(lastAcceptedID, env)
+			require.NoError(err)
+
+			// The tx span is a child of the span of the provided context, such
+			// as the span of the verification of the tx's block.
+			ctx, parentSpan := backend.Tracer.Start(context.Background(), "parent")
+			feeCalculator := state.PickFeeCalculator(env.config, stateDiff)
+			_, _, _, err = StandardTx(
+				ctx,
+				&backend,
+				feeCalculator,
+				tx,
+				stateDiff,
+			)
+			require.NoError(err)
+			parentSpan.End()
+
+			spans := recorder.Ended()
+			require.Len(spans, 2)
+			span := spans[0]
+			require.Equal(test.expectedSpanName, span.Name())
+			require.Equal(parentSpan.SpanContext().SpanID(), span.Parent().SpanID())
+
+			attributes := make(map[attribute.Key]attribute.Value)
+			for _, kv := range span.Attributes() {
+				attributes[kv.Key] = kv.Value
+			}
+			require.Equal(tx.ID().String(), attributes["txID"].AsString())
+			require.Len(attributes["inputIDs"].AsStringSlice(), tx.InputIDs().Len())
+
+			outs := tx.Unsigned.Outputs()
+			expectedOutputAmounts := make([]string, len(outs))
+			for i, out := range outs {
+				expectedOutputAmounts[i] = strconv.FormatUint(out.Out.Amount(), 10)
+			}
+			require.Equal(expectedOutputAmounts, attributes["outputAmounts"].AsStringSlice())
+		})
+	}
+}
+
+func TestTxSpanAttributesLargeOutputAmount(t *testing.T) {
+	tx := &txs.Tx{Unsigned: &txs.BaseTx{BaseTx: avax.BaseTx{
+		Outs: []*avax.TransferableOutput{{
+			Out: &secp256k1fx.TransferOutput{Amt: math.MaxUint64},
+		}},
+	}}}
+
+	attributes := txSpanAttributes(tx)
+	require.Contains(t, attributes, attribute.StringSlice("outputAmounts", []string{"18446744073709551615"}))
+}
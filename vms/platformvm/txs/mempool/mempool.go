@@ -4,16 +4,32 @@
 package mempool
 
 import (
+	"cmp"
 	"errors"
+	"slices"
+	"sync"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 
+	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
+	"github.com/ava-labs/avalanchego/utils/heap"
+	"github.com/ava-labs/avalanchego/utils/linked"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs/fee"
 
 	txmempool "github.com/ava-labs/avalanchego/vms/txs/mempool"
 )
 
+// maxDroppedTxs is the maximum number of drop reasons to track. Once exceeded,
+// the reason for the least recently dropped tx is forgotten.
+const maxDroppedTxs = 64
+
 var (
 	_ Mempool = (*mempool)(nil)
 
@@ -30,30 +46,168 @@ type Mempool interface {
 	// a notification will only be sent if there is at least one transaction in
 	// the mempool.
 	RequestBuildBlock(emptyBlockPermitted bool)
+
+	// GetByAddress returns the txs in the mempool that consume a UTXO owned by
+	// [addr]. The owners of a UTXO are looked up with the UTXOGetter provided
+	// by WithUTXOGetter when a tx is added. UTXOs that can't be found when the
+	// tx is added are not indexed. For example, if the UTXOGetter only returns
+	// accepted UTXOs, txs are not returned for imported UTXOs, UTXOs produced
+	// by processing blocks, or UTXOs produced by other txs in the mempool. If
+	// no UTXOGetter was provided, no txs are returned.
+	GetByAddress(addr ids.ShortID) []*txs.Tx
+
+	// GetConflicts returns the txs in the mempool that consume any of the
+	// inputs of [tx]. If there are no conflicts, nil is returned.
+	GetConflicts(tx *txs.Tx) []*txs.Tx
+
+	// GetHighestGasTxForAddress returns the tx in the mempool that consumes
+	// the most gas of the txs returned by GetByAddress. This is linear in the
+	// number of txs consuming UTXOs owned by [addr].
+	GetHighestGasTxForAddress(addr ids.ShortID) (*txs.Tx, bool)
+
+	// GasStats returns aggregate statistics of the gas consumed by the txs in
+	// the mempool.
+	GasStats() MempoolGasStats
+
+	// GetDropReasons returns the currently tracked reasons for which txs were
+	// dropped, keyed by txID.
+	GetDropReasons() map[ids.ID]error
+
+	// RejectExpiredDropReasons stops tracking the reasons for txs dropped more
+	// than [maxAge] ago and returns the number of reasons that were removed.
+	RejectExpiredDropReasons(maxAge time.Duration) int
+
+	// GetComplexityBudget simulates filling a block with at most
+	// [blockMaxComplexity] by greedily including the txs in the mempool in the
+	// order of the mempool's comparator. It returns the complexity that would
+	// remain and the number of txs that would be included. Txs whose complexity
+	// can't be calculated are never included.
+	GetComplexityBudget(blockMaxComplexity gas.Dimensions) (remaining gas.Dimensions, txCount int)
+
+	// Snapshot returns the txs in the mempool at a single point in time in the
+	// order of the mempool's comparator. The returned slice is not modified by
+	// subsequent changes to the mempool.
+	Snapshot() []Tx
+}
+
+// Tx is a tx in the mempool along with the gas it consumes.
+type Tx struct {
+	Tx  *txs.Tx
+	Gas gas.Gas
+}
+
+// Option configures the mempool returned by New.
+type Option func(*mempool)
+
+// WithComparator configures the order of the txs returned by Snapshot and
+// considered by GetComplexityBudget. [less] reports whether [a] should be
+// ordered before [b]. Txs that are not ordered by [less] are kept in the order
+// they were added, so a [less] that always returns false results in FIFO
+// ordering. Peek and Iterate are not affected and always return txs in the
+// order they were added.
+//
+// By default, txs that consume more gas are ordered first.
+func WithComparator(less func(a, b Tx) bool) Option {
+	return func(m *mempool) {
+		m.less = less
+	}
+}
+
+// WithUTXOGetter configures the mempool to index txs by the owners of the UTXOs
+// they consume. [getter] is called once per input of every added tx, without
+// any lock held by the mempool, so it must be safe to use concurrently with
+// the modification of the UTXO set.
+func WithUTXOGetter(getter avax.UTXOGetter) Option {
+	return func(m *mempool) {
+		m.utxoGetter = getter
+	}
+}
+
+// highestGasFirst is the default comparator of the mempool.
+func highestGasFirst(a, b Tx) bool {
+	return a.Gas > b.Gas
+}
+
+type MempoolGasStats struct {
+	TotalGas gas.Gas
+	MinGas   gas.Gas
+	MaxGas   gas.Gas
+	MeanGas  float64
+}
+
+type txMetadata struct {
+	addresses     set.Set[ids.ShortID]
+	complexity    gas.Dimensions
+	hasComplexity bool
+	gas           gas.Gas
+}
+
+type droppedTx struct {
+	reason    error
+	droppedAt time.Time
 }
 
 type mempool struct {
 	txmempool.Mempool[*txs.Tx]
+	weights    gas.Dimensions
+	gasMetrics *gasMetrics
+	toEngine   chan<- common.Message
+	clock      mockable.Clock
+	less       func(a, b Tx) bool
+	utxoGetter avax.UTXOGetter
+
+	// lock protects the secondary indices below. It is held across
+	// modifications of the underlying mempool to keep the indices consistent.
+	lock           sync.RWMutex
+	addressToTxIDs map[ids.ShortID]set.Set[ids.ID]
+	metadata       map[ids.ID]txMetadata
 
-	toEngine chan<- common.Message
+	// totalGas is maintained with wrapping arithmetic, so it is exact whenever
+	// the total gas of the txs in the mempool fits in a uint64.
+	totalGas gas.Gas
+	minGas   heap.Map[ids.ID, gas.Gas]
+	maxGas   heap.Map[ids.ID, gas.Gas]
+
+	// droppedTxs replaces the drop reason tracking of the underlying mempool
+	// so that the tracked reasons can be enumerated. Txs are ordered by when
+	// they were most recently dropped.
+	droppedTxs *linked.Hashmap[ids.ID, droppedTx]
 }
 
 func New(
 	namespace string,
+	weights gas.Dimensions,
 	registerer prometheus.Registerer,
 	toEngine chan<- common.Message,
+	opts ...Option,
 ) (Mempool, error) {
 	metrics, err := txmempool.NewMetrics(namespace, registerer)
 	if err != nil {
 		return nil, err
 	}
+	gasMetrics, err := newGasMetrics(namespace, registerer)
+	if err != nil {
+		return nil, err
+	}
 	pool := txmempool.New[*txs.Tx](
 		metrics,
 	)
-	return &mempool{
-		Mempool:  pool,
-		toEngine: toEngine,
-	}, nil
+	m := &mempool{
+		Mempool:        pool,
+		weights:        weights,
+		gasMetrics:     gasMetrics,
+		toEngine:       toEngine,
+		less:           highestGasFirst,
+		addressToTxIDs: make(map[ids.ShortID]set.Set[ids.ID]),
+		metadata:       make(map[ids.ID]txMetadata),
+		minGas:         heap.NewMap[ids.ID, gas.Gas](cmp.Less[gas.Gas]),
+		maxGas:         heap.NewMap[ids.ID, gas.Gas](func(a, b gas.Gas) bool { return a > b }),
+		droppedTxs:     linked.NewHashmap[ids.ID, droppedTx](),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m, nil
 }
 
 func (m *mempool) Add(tx *txs.Tx) error {
@@ -65,7 +219,274 @@ func (m *mempool) Add(tx *txs.Tx) error {
 	default:
 	}
 
-	return m.Mempool.Add(tx)
+	// The metadata is calculated before grabbing the lock to avoid performing
+	// database reads and complexity calculations while holding it.
+	var (
+		txID     = tx.ID()
+		inputIDs = tx.InputIDs()
+		metadata = newTxMetadata(m.weights, tx)
+	)
+	metadata.addresses = m.ownerAddresses(inputIDs)
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if err := m.Mempool.Add(tx); err != nil {
+		return err
+	}
+
+	for addr := range metadata.addresses {
+		txIDs, ok := m.addressToTxIDs[addr]
+		if !ok {
+			txIDs = set.NewSet[ids.ID](1)
+			m.addressToTxIDs[addr] = txIDs
+		}
+		txIDs.Add(txID)
+	}
+	m.metadata[txID] = metadata
+	m.totalGas += metadata.gas
+	m.minGas.Push(txID, metadata.gas)
+	m.maxGas.Push(txID, metadata.gas)
+	m.gasMetrics.Update(m.gasStats())
+
+	// An added tx must not be marked as dropped.
+	m.droppedTxs.Delete(txID)
+	return nil
+}
+
+func (m *mempool) Remove(txs ...*txs.Tx) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	// Any tx removed by the underlying mempool is either one of [txs] or
+	// consumes an input of [txs].
+	var candidates set.Set[ids.ID]
+	for _, tx := range txs {
+		candidates.Add(tx.ID())
+		candidates.Union(m.Mempool.GetConsumers(tx.InputIDs()))
+	}
+
+	m.Mempool.Remove(txs...)
+
+	for txID := range candidates {
+		if _, ok := m.Mempool.Get(txID); !ok {
+			m.deindex(txID)
+		}
+	}
+	m.gasMetrics.Update(m.gasStats())
+}
+
+func (m *mempool) deindex(txID ids.ID) {
+	metadata, ok := m.metadata[txID]
+	if !ok {
+		return
+	}
+
+	for addr := range metadata.addresses {
+		txIDs := m.addressToTxIDs[addr]
+		txIDs.Remove(txID)
+		if txIDs.Len() == 0 {
+			delete(m.addressToTxIDs, addr)
+		}
+	}
+	m.totalGas -= metadata.gas
+	m.minGas.Remove(txID)
+	m.maxGas.Remove(txID)
+	delete(m.metadata, txID)
+}
+
+func (m *mempool) GetByAddress(addr ids.ShortID) []*txs.Tx {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	txIDs := m.addressToTxIDs[addr]
+	result := make([]*txs.Tx, 0, txIDs.Len())
+	for txID := range txIDs {
+		if tx, ok := m.Mempool.Get(txID); ok {
+			result = append(result, tx)
+		}
+	}
+	return result
+}
+
+func (m *mempool) GetConflicts(tx *txs.Tx) []*txs.Tx {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	conflictTxIDs := m.Mempool.GetConsumers(tx.InputIDs())
+	conflictTxIDs.Remove(tx.ID())
+
+	var conflicts []*txs.Tx
+	for conflictTxID := range conflictTxIDs {
+		if conflictTx, ok := m.Mempool.Get(conflictTxID); ok {
+			conflicts = append(conflicts, conflictTx)
+		}
+	}
+	return conflicts
+}
+
+func (m *mempool) GetHighestGasTxForAddress(addr ids.ShortID) (*txs.Tx, bool) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	var (
+		highestTxID ids.ID
+		highestGas  gas.Gas
+		found       bool
+	)
+	for txID := range m.addressToTxIDs[addr] {
+		txGas := m.metadata[txID].gas
+		if !found || txGas > highestGas {
+			highestTxID = txID
+			highestGas = txGas
+			found = true
+		}
+	}
+	if !found {
+		return nil, false
+	}
+	return m.Mempool.Get(highestTxID)
+}
+
+func (m *mempool) GasStats() MempoolGasStats {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return m.gasStats()
+}
+
+// gasStats assumes the lock is held.
+func (m *mempool) gasStats() MempoolGasStats {
+	numTxs := m.minGas.Len()
+	if numTxs == 0 {
+		return MempoolGasStats{}
+	}
+
+	_, minGas, _ := m.minGas.Peek()
+	_, maxGas, _ := m.maxGas.Peek()
+	return MempoolGasStats{
+		TotalGas: m.totalGas,
+		MinGas:   minGas,
+		MaxGas:   maxGas,
+		MeanGas:  float64(m.totalGas) / float64(numTxs),
+	}
+}
+
+func (m *mempool) MarkDropped(txID ids.ID, reason error) {
+	if errors.Is(reason, txmempool.ErrMempoolFull) {
+		return
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.Mempool.Get(txID); ok {
+		return
+	}
+
+	m.droppedTxs.Put(txID, droppedTx{
+		reason:    reason,
+		droppedAt: m.clock.Time(),
+	})
+	if m.droppedTxs.Len() > maxDroppedTxs {
+		oldestTxID, _, _ := m.droppedTxs.Oldest()
+		m.droppedTxs.Delete(oldestTxID)
+	}
+}
+
+func (m *mempool) GetDropReason(txID ids.ID) error {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	dropped, _ := m.droppedTxs.Get(txID)
+	return dropped.reason
+}
+
+func (m *mempool) GetDropReasons() map[ids.ID]error {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	reasons := make(map[ids.ID]error, m.droppedTxs.Len())
+	it := m.droppedTxs.NewIterator()
+	for it.Next() {
+		reasons[it.Key()] = it.Value().reason
+	}
+	return reasons
+}
+
+func (m *mempool) RejectExpiredDropReasons(maxAge time.Duration) int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	var (
+		expiry  = m.clock.Time().Add(-maxAge)
+		removed int
+	)
+	for {
+		txID, dropped, ok := m.droppedTxs.Oldest()
+		if !ok || !dropped.droppedAt.Before(expiry) {
+			return removed
+		}
+		m.droppedTxs.Delete(txID)
+		removed++
+	}
+}
+
+func (m *mempool) GetComplexityBudget(blockMaxComplexity gas.Dimensions) (gas.Dimensions, int) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	var (
+		remaining = blockMaxComplexity
+		txCount   int
+	)
+	for _, tx := range m.sortedTxs() {
+		metadata := m.metadata[tx.Tx.ID()]
+		if !metadata.hasComplexity {
+			continue
+		}
+		newRemaining, err := remaining.Sub(&metadata.complexity)
+		if err != nil {
+			// The tx doesn't fit in the remaining budget
+			continue
+		}
+		remaining = newRemaining
+		txCount++
+	}
+	return remaining, txCount
+}
+
+func (m *mempool) Snapshot() []Tx {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return m.sortedTxs()
+}
+
+// sortedTxs returns the txs in the mempool in the order of the mempool's
+// comparator.
+//
+// sortedTxs assumes the lock is held.
+func (m *mempool) sortedTxs() []Tx {
+	sorted := make([]Tx, 0, len(m.metadata))
+	m.Mempool.Iterate(func(tx *txs.Tx) bool {
+		sorted = append(sorted, Tx{
+			Tx:  tx,
+			Gas: m.metadata[tx.ID()].gas,
+		})
+		return true
+	})
+	slices.SortStableFunc(sorted, func(a, b Tx) int {
+		switch {
+		case m.less(a, b):
+			return -1
+		case m.less(b, a):
+			return 1
+		default:
+			return 0
+		}
+	})
+	return sorted
 }
 
 func (m *mempool) RequestBuildBlock(emptyBlockPermitted bool) {
@@ -73,8 +494,56 @@ func (m *mempool) RequestBuildBlock(emptyBlockPermitted bool) {
 		return
 	}
 
-	select {
-	case m.toEngine <- common.PendingTxs:
-	default:
+	common.DrainToEngine(m.toEngine, common.PendingTxs)
+}
+
+// ownerAddresses returns the owners of the UTXOs with the provided IDs. UTXOs
+// that can't be found or that don't have addressable owners are ignored.
+func (m *mempool) ownerAddresses(utxoIDs set.Set[ids.ID]) set.Set[ids.ShortID] {
+	var addrs set.Set[ids.ShortID]
+	if m.utxoGetter == nil {
+		return addrs
+	}
+	for utxoID := range utxoIDs {
+		utxo, err := m.utxoGetter.GetUTXO(utxoID)
+		if err != nil {
+			continue
+		}
+		addressable, ok := utxo.Out.(avax.Addressable)
+		if !ok {
+			continue
+		}
+		for _, addrBytes := range addressable.Addresses() {
+			addr, err := ids.ToShortID(addrBytes)
+			if err != nil {
+				continue
+			}
+			addrs.Add(addr)
+		}
 	}
+	return addrs
+}
+
+// newTxMetadata returns the metadata of [tx] other than its owner addresses.
+// Txs whose complexity can't be calculated (e.g. txs deprecated by Etna) are
+// treated as consuming no gas.
+func newTxMetadata(weights gas.Dimensions, tx *txs.Tx) txMetadata {
+	complexity, err := fee.TxComplexity(tx.Unsigned)
+	if err != nil {
+		return txMetadata{}
+	}
+	metadata := txMetadata{
+		complexity:    complexity,
+		hasComplexity: true,
+	}
+	metadata.gas, err = complexity.ToGas(weights)
+	if err != nil {
+		metadata.gas = 0
+	}
+	return metadata
+}
+
+// txGas returns the gas consumed by [tx] with [weights].
+func txGas(weights gas.Dimensions, tx *txs.Tx) gas.Gas {
+	return newTxMetadata(weights, tx).gas
 }
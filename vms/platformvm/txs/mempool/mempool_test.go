@@ -0,0 +1,507 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package mempool
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs/fee"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+
+	txmempool "github.com/ava-labs/avalanchego/vms/txs/mempool"
+)
+
+var (
+	testKeys = secp256k1.TestKeys()
+
+	testWeights = gas.Dimensions{
+		gas.Bandwidth: 1,
+		gas.DBRead:    2000,
+		gas.DBWrite:   20000,
+		gas.Compute:   10,
+	}
+)
+
+// newTestTx returns a BaseTx consuming the provided UTXOs signed by [key].
+func newTestTx(t *testing.T, key *secp256k1.PrivateKey, utxoIDs ...avax.UTXOID) *txs.Tx {
+	ins := make([]*avax.TransferableInput, len(utxoIDs))
+	signers := make([][]*secp256k1.PrivateKey, len(utxoIDs))
+	for i, utxoID := range utxoIDs {
+		ins[i] = &avax.TransferableInput{
+			UTXOID: utxoID,
+			Asset:  avax.Asset{ID: ids.GenerateTestID()},
+			In: &secp256k1fx.TransferInput{
+				Amt:   1,
+				Input: secp256k1fx.Input{SigIndices: []uint32{0}},
+			},
+		}
+		signers[i] = []*secp256k1.PrivateKey{key}
+	}
+	utx := &txs.BaseTx{BaseTx: avax.BaseTx{
+		NetworkID:    constants.UnitTestID,
+		BlockchainID: constants.PlatformChainID,
+		Ins:          ins,
+	}}
+	tx, err := txs.NewSigned(utx, txs.Codec, signers)
+	require.NoError(t, err)
+	return tx
+}
+
+func newTestUTXOID() avax.UTXOID {
+	return avax.UTXOID{TxID: ids.GenerateTestID()}
+}
+
+type testUTXOGetter map[ids.ID]*avax.UTXO
+
+func (g testUTXOGetter) GetUTXO(utxoID ids.ID) (*avax.UTXO, error) {
+	utxo, ok := g[utxoID]
+	if !ok {
+		return nil, database.ErrNotFound
+	}
+	return utxo, nil
+}
+
+// newOwnedUTXOID returns a new UTXOID whose UTXO, owned by [addrs], is
+// returned by [getter].
+func (g testUTXOGetter) newOwnedUTXOID(addrs ...ids.ShortID) avax.UTXOID {
+	utxoID := newTestUTXOID()
+	g[utxoID.InputID()] = &avax.UTXO{
+		UTXOID: utxoID,
+		Asset:  avax.Asset{ID: ids.GenerateTestID()},
+		Out: &secp256k1fx.TransferOutput{
+			Amt: 1,
+			OutputOwners: secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     addrs,
+			},
+		},
+	}
+	return utxoID
+}
+
+func TestGetByAddress(t *testing.T) {
+	require := require.New(t)
+
+	getter := testUTXOGetter{}
+	m, err := New("", testWeights, prometheus.NewRegistry(), nil, WithUTXOGetter(getter))
+	require.NoError(err)
+
+	var (
+		addr0 = ids.GenerateTestShortID()
+		addr1 = ids.GenerateTestShortID()
+		addr2 = ids.GenerateTestShortID()
+		addr3 = ids.GenerateTestShortID()
+
+		// The signer of a tx doesn't affect the index
+		tx0 = newTestTx(t, testKeys[0], getter.newOwnedUTXOID(addr0))
+		// Unknown UTXOs, such as imported UTXOs, are not indexed
+		tx1 = newTestTx(t, testKeys[1], getter.newOwnedUTXOID(addr0), newTestUTXOID())
+		// Every owner of a multisig UTXO is indexed
+		tx2 = newTestTx(t, testKeys[0], getter.newOwnedUTXOID(addr1, addr2))
+		tx3 = newTestTx(t, testKeys[0], newTestUTXOID())
+	)
+	require.NoError(m.Add(tx0))
+	require.NoError(m.Add(tx1))
+	require.NoError(m.Add(tx2))
+	require.NoError(m.Add(tx3))
+
+	require.ElementsMatch([]*txs.Tx{tx0, tx1}, m.GetByAddress(addr0))
+	require.ElementsMatch([]*txs.Tx{tx2}, m.GetByAddress(addr1))
+	require.ElementsMatch([]*txs.Tx{tx2}, m.GetByAddress(addr2))
+	require.Empty(m.GetByAddress(addr3))
+	require.Empty(m.GetByAddress(testKeys[0].Address()))
+
+	m.Remove(tx0)
+	require.ElementsMatch([]*txs.Tx{tx1}, m.GetByAddress(addr0))
+
+	// Removing a conflicting tx that isn't in the mempool removes tx2
+	conflictingTx := newTestTx(t, testKeys[2], tx2.Unsigned.(*txs.BaseTx).Ins[0].UTXOID)
+	m.Remove(conflictingTx)
+	require.Empty(m.GetByAddress(addr1))
+	require.Empty(m.GetByAddress(addr2))
+}
+
+func TestGetByAddressWithoutUTXOGetter(t *testing.T) {
+	require := require.New(t)
+
+	m, err := New("", testWeights, prometheus.NewRegistry(), nil)
+	require.NoError(err)
+
+	require.NoError(m.Add(newTestTx(t, testKeys[0], newTestUTXOID())))
+	require.Empty(m.GetByAddress(testKeys[0].Address()))
+}
+
+func TestGetConflicts(t *testing.T) {
+	var (
+		utxo0 = newTestUTXOID()
+		utxo1 = newTestUTXOID()
+		utxo2 = newTestUTXOID()
+
+		tx0 = newTestTx(t, testKeys[0], utxo0)
+		tx1 = newTestTx(t, testKeys[1], utxo1)
+	)
+	tests := []struct {
+		name              string
+		tx                *txs.Tx
+		expectedConflicts []*txs.Tx
+	}{
+		{
+			name:              "no conflicts",
+			tx:                newTestTx(t, testKeys[2], utxo2),
+			expectedConflicts: nil,
+		},
+		{
+			name:              "single conflict",
+			tx:                newTestTx(t, testKeys[2], utxo0, utxo2),
+			expectedConflicts: []*txs.Tx{tx0},
+		},
+		{
+			name:              "multiple conflicts",
+			tx:                newTestTx(t, testKeys[2], utxo0, utxo1),
+			expectedConflicts: []*txs.Tx{tx0, tx1},
+		},
+		{
+			name:              "tx does not conflict with itself",
+			tx:                tx0,
+			expectedConflicts: nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			m, err := New("", testWeights, prometheus.NewRegistry(), nil)
+			require.NoError(err)
+			require.NoError(m.Add(tx0))
+			require.NoError(m.Add(tx1))
+
+			conflicts := m.GetConflicts(test.tx)
+			if test.expectedConflicts == nil {
+				require.Nil(conflicts)
+				return
+			}
+			require.ElementsMatch(test.expectedConflicts, conflicts)
+		})
+	}
+}
+
+func TestGetHighestGasTxForAddress(t *testing.T) {
+	require := require.New(t)
+
+	getter := testUTXOGetter{}
+	m, err := New("", testWeights, prometheus.NewRegistry(), nil, WithUTXOGetter(getter))
+	require.NoError(err)
+
+	var (
+		addr0 = ids.GenerateTestShortID()
+		addr1 = ids.GenerateTestShortID()
+
+		// Each input increases the complexity, and therefore the gas, of a tx
+		lowGasTx  = newTestTx(t, testKeys[0], getter.newOwnedUTXOID(addr0))
+		highGasTx = newTestTx(t, testKeys[0], getter.newOwnedUTXOID(addr0), newTestUTXOID(), newTestUTXOID())
+		midGasTx  = newTestTx(t, testKeys[0], getter.newOwnedUTXOID(addr0), newTestUTXOID())
+	)
+	require.NoError(m.Add(lowGasTx))
+	require.NoError(m.Add(highGasTx))
+	require.NoError(m.Add(midGasTx))
+
+	tx, ok := m.GetHighestGasTxForAddress(addr0)
+	require.True(ok)
+	require.Equal(highGasTx, tx)
+
+	m.Remove(highGasTx)
+	tx, ok = m.GetHighestGasTxForAddress(addr0)
+	require.True(ok)
+	require.Equal(midGasTx, tx)
+
+	_, ok = m.GetHighestGasTxForAddress(addr1)
+	require.False(ok)
+}
+
+func TestGasStats(t *testing.T) {
+	require := require.New(t)
+
+	m, err := New("", testWeights, prometheus.NewRegistry(), nil)
+	require.NoError(err)
+	require.Equal(MempoolGasStats{}, m.GasStats())
+
+	var (
+		tx0 = newTestTx(t, testKeys[0], newTestUTXOID())
+		tx1 = newTestTx(t, testKeys[0], newTestUTXOID(), newTestUTXOID())
+		tx2 = newTestTx(t, testKeys[0], newTestUTXOID(), newTestUTXOID(), newTestUTXOID())
+
+		gas0 = txGas(testWeights, tx0)
+		gas1 = txGas(testWeights, tx1)
+		gas2 = txGas(testWeights, tx2)
+	)
+	require.Less(gas0, gas1)
+	require.Less(gas1, gas2)
+
+	require.NoError(m.Add(tx0))
+	require.NoError(m.Add(tx1))
+	require.NoError(m.Add(tx2))
+
+	totalGas := gas0 + gas1 + gas2
+	expectedStats := MempoolGasStats{
+		TotalGas: totalGas,
+		MinGas:   gas0,
+		MaxGas:   gas2,
+		MeanGas:  float64(totalGas) / 3,
+	}
+	require.Equal(expectedStats, m.GasStats())
+
+	gasMetrics := m.(*mempool).gasMetrics
+	require.Equal(float64(expectedStats.TotalGas), testutil.ToFloat64(gasMetrics.totalGas))
+	require.Equal(float64(expectedStats.MinGas), testutil.ToFloat64(gasMetrics.minGas))
+	require.Equal(float64(expectedStats.MaxGas), testutil.ToFloat64(gasMetrics.maxGas))
+	require.Equal(expectedStats.MeanGas, testutil.ToFloat64(gasMetrics.meanGas))
+
+	m.Remove(tx0, tx2)
+	expectedStats = MempoolGasStats{
+		TotalGas: gas1,
+		MinGas:   gas1,
+		MaxGas:   gas1,
+		MeanGas:  float64(gas1),
+	}
+	require.Equal(expectedStats, m.GasStats())
+	require.Equal(float64(expectedStats.TotalGas), testutil.ToFloat64(gasMetrics.totalGas))
+
+	// Removing a conflicting tx that isn't in the mempool removes tx1
+	conflictingTx := newTestTx(t, testKeys[1], tx1.Unsigned.(*txs.BaseTx).Ins[1].UTXOID)
+	m.Remove(conflictingTx)
+	require.Equal(MempoolGasStats{}, m.GasStats())
+	require.Zero(testutil.ToFloat64(gasMetrics.totalGas))
+
+	// A tx without inputs is deindexed when it is removed
+	noInputsTx := newTestTx(t, testKeys[0])
+	require.NoError(m.Add(noInputsTx))
+	require.NotEqual(MempoolGasStats{}, m.GasStats())
+
+	m.Remove(noInputsTx)
+	require.Equal(MempoolGasStats{}, m.GasStats())
+	require.Zero(testutil.ToFloat64(gasMetrics.totalGas))
+}
+
+func TestGetDropReasons(t *testing.T) {
+	require := require.New(t)
+
+	m, err := New("", testWeights, prometheus.NewRegistry(), nil)
+	require.NoError(err)
+	require.Empty(m.GetDropReasons())
+
+	var (
+		errTest0 = errors.New("test error 0")
+		errTest1 = errors.New("test error 1")
+
+		tx0 = newTestTx(t, testKeys[0], newTestUTXOID())
+		tx1 = newTestTx(t, testKeys[0], newTestUTXOID())
+		tx2 = newTestTx(t, testKeys[0], newTestUTXOID())
+	)
+	require.NoError(m.Add(tx2))
+
+	m.MarkDropped(tx0.ID(), errTest0)
+	m.MarkDropped(tx1.ID(), errTest1)
+	// Txs in the mempool and txs dropped because the mempool is full are not
+	// tracked.
+	m.MarkDropped(tx2.ID(), errTest0)
+	m.MarkDropped(ids.GenerateTestID(), txmempool.ErrMempoolFull)
+
+	require.Equal(
+		map[ids.ID]error{
+			tx0.ID(): errTest0,
+			tx1.ID(): errTest1,
+		},
+		m.GetDropReasons(),
+	)
+	require.ErrorIs(m.GetDropReason(tx0.ID()), errTest0)
+
+	// Adding a dropped tx clears its drop reason
+	require.NoError(m.Add(tx0))
+	require.NoError(m.GetDropReason(tx0.ID()))
+	require.Equal(
+		map[ids.ID]error{
+			tx1.ID(): errTest1,
+		},
+		m.GetDropReasons(),
+	)
+
+	// Only the most recently dropped txs are tracked
+	for range maxDroppedTxs {
+		m.MarkDropped(ids.GenerateTestID(), errTest0)
+	}
+	dropReasons := m.GetDropReasons()
+	require.Len(dropReasons, maxDroppedTxs)
+	require.NotContains(dropReasons, tx1.ID())
+}
+
+func TestRejectExpiredDropReasons(t *testing.T) {
+	require := require.New(t)
+
+	m, err := New("", testWeights, prometheus.NewRegistry(), nil)
+	require.NoError(err)
+
+	var (
+		errTest = errors.New("test error")
+		clock   = &m.(*mempool).clock
+		now     = time.Now()
+
+		txID0 = ids.GenerateTestID()
+		txID1 = ids.GenerateTestID()
+		txID2 = ids.GenerateTestID()
+	)
+	clock.Set(now)
+	m.MarkDropped(txID0, errTest)
+	m.MarkDropped(txID1, errTest)
+
+	clock.Set(now.Add(time.Minute))
+	m.MarkDropped(txID2, errTest)
+	// Dropping a tx again refreshes its age
+	m.MarkDropped(txID1, errTest)
+
+	clock.Set(now.Add(2 * time.Minute))
+	require.Zero(m.RejectExpiredDropReasons(2 * time.Minute))
+	require.Equal(1, m.RejectExpiredDropReasons(time.Minute))
+	require.Equal(
+		map[ids.ID]error{
+			txID1: errTest,
+			txID2: errTest,
+		},
+		m.GetDropReasons(),
+	)
+
+	require.Equal(2, m.RejectExpiredDropReasons(0))
+	require.Empty(m.GetDropReasons())
+}
+
+func TestGetComplexityBudget(t *testing.T) {
+	require := require.New(t)
+
+	m, err := New("", testWeights, prometheus.NewRegistry(), nil)
+	require.NoError(err)
+
+	var (
+		lowGasTx  = newTestTx(t, testKeys[0], newTestUTXOID())
+		midGasTx  = newTestTx(t, testKeys[0], newTestUTXOID(), newTestUTXOID())
+		highGasTx = newTestTx(t, testKeys[0], newTestUTXOID(), newTestUTXOID(), newTestUTXOID())
+	)
+	lowComplexity, err := fee.TxComplexity(lowGasTx.Unsigned)
+	require.NoError(err)
+	highComplexity, err := fee.TxComplexity(highGasTx.Unsigned)
+	require.NoError(err)
+
+	require.NoError(m.Add(lowGasTx))
+	require.NoError(m.Add(midGasTx))
+	require.NoError(m.Add(highGasTx))
+
+	// The highest gas tx is included first, after which only the lowest gas tx
+	// fits.
+	blockMaxComplexity, err := highComplexity.Add(&lowComplexity)
+	require.NoError(err)
+	remaining, txCount := m.GetComplexityBudget(blockMaxComplexity)
+	require.Equal(gas.Dimensions{}, remaining)
+	require.Equal(2, txCount)
+
+	remaining, txCount = m.GetComplexityBudget(gas.Dimensions{})
+	require.Equal(gas.Dimensions{}, remaining)
+	require.Zero(txCount)
+}
+
+func TestWithComparator(t *testing.T) {
+	var (
+		lowGasTx  = newTestTx(t, testKeys[0], newTestUTXOID())
+		midGasTx  = newTestTx(t, testKeys[0], newTestUTXOID(), newTestUTXOID())
+		highGasTx = newTestTx(t, testKeys[0], newTestUTXOID(), newTestUTXOID(), newTestUTXOID())
+	)
+	tests := []struct {
+		name          string
+		opts          []Option
+		expectedOrder []*txs.Tx
+	}{
+		{
+			name:          "default",
+			expectedOrder: []*txs.Tx{highGasTx, midGasTx, lowGasTx},
+		},
+		{
+			name: "fifo",
+			opts: []Option{
+				WithComparator(func(Tx, Tx) bool { return false }),
+			},
+			expectedOrder: []*txs.Tx{midGasTx, lowGasTx, highGasTx},
+		},
+		{
+			name: "lowest gas first",
+			opts: []Option{
+				WithComparator(func(a, b Tx) bool { return a.Gas < b.Gas }),
+			},
+			expectedOrder: []*txs.Tx{lowGasTx, midGasTx, highGasTx},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			m, err := New("", testWeights, prometheus.NewRegistry(), nil, test.opts...)
+			require.NoError(err)
+			require.NoError(m.Add(midGasTx))
+			require.NoError(m.Add(lowGasTx))
+			require.NoError(m.Add(highGasTx))
+
+			sortedTxs := m.(*mempool).sortedTxs()
+			order := make([]*txs.Tx, len(sortedTxs))
+			for i, tx := range sortedTxs {
+				require.Equal(txGas(testWeights, tx.Tx), tx.Gas)
+				order[i] = tx.Tx
+			}
+			require.Equal(test.expectedOrder, order)
+		})
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	require := require.New(t)
+
+	m, err := New("", testWeights, prometheus.NewRegistry(), nil)
+	require.NoError(err)
+	require.Empty(m.Snapshot())
+
+	var (
+		lowGasTx  = newTestTx(t, testKeys[0], newTestUTXOID())
+		midGasTx  = newTestTx(t, testKeys[0], newTestUTXOID(), newTestUTXOID())
+		highGasTx = newTestTx(t, testKeys[0], newTestUTXOID(), newTestUTXOID(), newTestUTXOID())
+	)
+	require.NoError(m.Add(lowGasTx))
+	require.NoError(m.Add(highGasTx))
+
+	expectedSnapshot := []Tx{
+		{Tx: highGasTx, Gas: txGas(testWeights, highGasTx)},
+		{Tx: lowGasTx, Gas: txGas(testWeights, lowGasTx)},
+	}
+	snapshot := m.Snapshot()
+	require.Equal(expectedSnapshot, snapshot)
+
+	// The snapshot is independent of subsequent changes to the mempool
+	require.NoError(m.Add(midGasTx))
+	m.Remove(highGasTx)
+	require.Equal(expectedSnapshot, snapshot)
+	require.Equal(
+		[]Tx{
+			{Tx: midGasTx, Gas: txGas(testWeights, midGasTx)},
+			{Tx: lowGasTx, Gas: txGas(testWeights, lowGasTx)},
+		},
+		m.Snapshot(),
+	)
+}
@@ -0,0 +1,57 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package mempool
+
+import (
+	"errors"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type gasMetrics struct {
+	totalGas prometheus.Gauge
+	minGas   prometheus.Gauge
+	maxGas   prometheus.Gauge
+	meanGas  prometheus.Gauge
+}
+
+func newGasMetrics(namespace string, registerer prometheus.Registerer) (*gasMetrics, error) {
+	m := &gasMetrics{
+		totalGas: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "gas_total",
+			Help:      "Total gas consumed by the transactions in the mempool",
+		}),
+		minGas: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "gas_min",
+			Help:      "Minimum gas consumed by a transaction in the mempool",
+		}),
+		maxGas: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "gas_max",
+			Help:      "Maximum gas consumed by a transaction in the mempool",
+		}),
+		meanGas: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "gas_mean",
+			Help:      "Mean gas consumed by the transactions in the mempool",
+		}),
+	}
+
+	err := errors.Join(
+		registerer.Register(m.totalGas),
+		registerer.Register(m.minGas),
+		registerer.Register(m.maxGas),
+		registerer.Register(m.meanGas),
+	)
+	return m, err
+}
+
+func (m *gasMetrics) Update(stats MempoolGasStats) {
+	m.totalGas.Set(float64(stats.TotalGas))
+	m.minGas.Set(float64(stats.MinGas))
+	m.maxGas.Set(float64(stats.MaxGas))
+	m.meanGas.Set(stats.MeanGas)
+}
@@ -120,7 +120,7 @@ func (tx *TransformSubnetTx) SyntacticVerify(ctx *snow.Context) error {
 		return nil
 	case tx.Subnet == constants.PrimaryNetworkID:
 		return errCantTransformPrimaryNetwork
-	case tx.AssetID == ids.Empty:
+	case tx.AssetID.IsZero():
 		return errEmptyAssetID
 	case tx.AssetID == ctx.AVAXAssetID:
 		return errAssetIDCantBeAVAX
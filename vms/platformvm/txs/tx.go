@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/network/p2p/gossip"
 	"github.com/ava-labs/avalanchego/snow"
@@ -16,13 +17,16 @@ import (
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 )
 
 var (
 	_ gossip.Gossipable = (*Tx)(nil)
 
-	ErrNilSignedTx = errors.New("nil signed tx is not valid")
+	ErrNilSignedTx              = errors.New("nil signed tx is not valid")
+	ErrWrongNumberOfCredentials = errors.New("wrong number of credentials")
+	ErrInvalidSignature         = errors.New("invalid signature")
 
 	errSignedTxNotInitialized = errors.New("signed tx was never initialized and is not valid")
 )
@@ -101,6 +105,24 @@ func (tx *Tx) ID() ids.ID {
 	return tx.TxID
 }
 
+// ComputeID returns the ID of the tx. If the ID was never set, it is computed
+// from the signed bytes of the tx and cached. If the tx was never initialized,
+// it is initialized with [Codec].
+func (tx *Tx) ComputeID() (ids.ID, error) {
+	if !tx.TxID.IsZero() {
+		return tx.TxID, nil
+	}
+	if len(tx.bytes) == 0 {
+		if err := tx.Initialize(Codec); err != nil {
+			return ids.Empty, err
+		}
+		return tx.TxID, nil
+	}
+
+	tx.TxID = hashing.ComputeHash256Array(tx.bytes)
+	return tx.TxID, nil
+}
+
 func (tx *Tx) GossipID() ids.ID {
 	return tx.TxID
 }
@@ -131,13 +153,146 @@ func (tx *Tx) SyntacticVerify(ctx *snow.Context) error {
 	switch {
 	case tx == nil:
 		return ErrNilSignedTx
-	case tx.TxID == ids.Empty:
+	case tx.TxID.IsZero():
 		return errSignedTxNotInitialized
 	default:
 		return tx.Unsigned.SyntacticVerify(ctx)
 	}
 }
 
+// VerifySignatures verifies the tx syntactically and that every credential
+// matches the input it authorizes: the number of signatures must equal the
+// number of signature indices of the input, and every signature must be
+// recoverable from the unsigned bytes of the tx. Because the consumed UTXOs
+// aren't read, this doesn't verify that the signers are authorized to spend
+// the inputs. See VerifySignaturesWithUTXOs.
+func (tx *Tx) VerifySignatures(ctx *snow.Context) error {
+	return tx.VerifySignaturesWithUTXOs(ctx, nil)
+}
+
+// VerifySignaturesWithUTXOs performs the checks of VerifySignatures and
+// additionally verifies that the signers of every input consuming a UTXO
+// returned by [utxos] are the owners of that UTXO referenced by the input.
+// Inputs whose UTXOs can't be found, imported inputs, and subnet
+// authorizations aren't checked against their owners. If [utxos] is nil, no
+// owners are checked.
+func (tx *Tx) VerifySignaturesWithUTXOs(ctx *snow.Context, utxos avax.UTXOGetter) error {
+	if err := tx.SyntacticVerify(ctx); err != nil {
+		return err
+	}
+
+	inputs := credentialInputs{}
+	if err := tx.Unsigned.Visit(&inputs); err != nil {
+		return err
+	}
+	if len(tx.Creds) != len(inputs.inputs) {
+		return fmt.Errorf("%w: %d credentials != %d inputs",
+			ErrWrongNumberOfCredentials,
+			len(tx.Creds),
+			len(inputs.inputs),
+		)
+	}
+
+	unsignedHash := hashing.ComputeHash256(tx.Unsigned.Bytes())
+	for i, verifiable := range tx.Creds {
+		if err := verifiable.Verify(); err != nil {
+			return fmt.Errorf("credential %d failed verification: %w", i, err)
+		}
+
+		in := inputs.inputs[i]
+		if in.input == nil {
+			continue
+		}
+		cred, ok := verifiable.(*secp256k1fx.Credential)
+		if !ok {
+			return fmt.Errorf("credential %d: %w", i, secp256k1fx.ErrWrongCredentialType)
+		}
+		if err := verifyCredential(unsignedHash, in, cred, utxos); err != nil {
+			return fmt.Errorf("credential %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func verifyCredential(
+	unsignedHash []byte,
+	in credentialInput,
+	cred *secp256k1fx.Credential,
+	utxos avax.UTXOGetter,
+) error {
+	sigIndices := in.input.SigIndices
+	if len(cred.Sigs) != len(sigIndices) {
+		return fmt.Errorf("%w: %d signatures != %d signature indices",
+			secp256k1fx.ErrInputCredentialSignersMismatch,
+			len(cred.Sigs),
+			len(sigIndices),
+		)
+	}
+
+	owners, err := utxoOwners(in.utxoID, utxos)
+	if err != nil {
+		return err
+	}
+	if owners != nil {
+		switch numSigs := uint32(len(sigIndices)); {
+		case numSigs < owners.Threshold:
+			return secp256k1fx.ErrTooFewSigners
+		case numSigs > owners.Threshold:
+			return secp256k1fx.ErrTooManySigners
+		}
+	}
+
+	for i, sig := range cred.Sigs {
+		pk, err := secp256k1.RecoverPublicKeyFromHash(unsignedHash, sig[:])
+		if err != nil {
+			return fmt.Errorf("%w: signature %d: %w", ErrInvalidSignature, i, err)
+		}
+		if owners == nil {
+			continue
+		}
+
+		index := sigIndices[i]
+		if index >= uint32(len(owners.Addrs)) {
+			return secp256k1fx.ErrInputOutputIndexOutOfBounds
+		}
+		if expectedAddr, addr := owners.Addrs[index], pk.Address(); expectedAddr != addr {
+			return fmt.Errorf("%w: signature %d: expected signature from %s but got from %s",
+				secp256k1fx.ErrWrongSig,
+				i,
+				expectedAddr,
+				addr,
+			)
+		}
+	}
+	return nil
+}
+
+// utxoOwners returns the owners of the UTXO with [utxoID]. If the UTXO can't be
+// found or isn't owned by secp256k1fx owners, nil is returned.
+func utxoOwners(utxoID *avax.UTXOID, utxos avax.UTXOGetter) (*secp256k1fx.OutputOwners, error) {
+	if utxoID == nil || utxos == nil {
+		return nil, nil
+	}
+
+	utxo, err := utxos.GetUTXO(utxoID.InputID())
+	if err == database.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get UTXO %s: %w", utxoID, err)
+	}
+
+	out := utxo.Out
+	if lockOut, ok := out.(*stakeable.LockOut); ok {
+		out = lockOut.TransferableOut
+	}
+	transferOut, ok := out.(*secp256k1fx.TransferOutput)
+	if !ok {
+		return nil, nil
+	}
+	return &transferOut.OutputOwners, nil
+}
+
 // Sign this transaction with the provided signers
 // Note: We explicitly pass the codec in Sign since we may need to sign P-Chain
 // genesis txs whose length exceed the max length of txs.Codec.
@@ -0,0 +1,235 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestTxComputeID(t *testing.T) {
+	require := require.New(t)
+
+	tx := &Tx{Unsigned: &BaseTx{BaseTx: avax.BaseTx{
+		NetworkID:    constants.UnitTestID,
+		BlockchainID: constants.PlatformChainID,
+	}}}
+
+	// A tx that was never initialized is initialized.
+	txID, err := tx.ComputeID()
+	require.NoError(err)
+	require.Equal(txID, tx.ID())
+	require.NotEmpty(tx.Bytes())
+	require.NotEmpty(tx.Unsigned.Bytes())
+	require.Equal(ids.ID(hashing.ComputeHash256Array(tx.Bytes())), txID)
+
+	expectedTx := &Tx{Unsigned: &BaseTx{BaseTx: avax.BaseTx{
+		NetworkID:    constants.UnitTestID,
+		BlockchainID: constants.PlatformChainID,
+	}}}
+	require.NoError(expectedTx.Initialize(Codec))
+	require.Equal(expectedTx.Bytes(), tx.Bytes())
+	require.Equal(expectedTx.Unsigned.Bytes(), tx.Unsigned.Bytes())
+	require.Equal(expectedTx.ID(), txID)
+
+	tx.TxID = ids.Empty
+	txID, err = tx.ComputeID()
+	require.NoError(err)
+	require.Equal(ids.ID(hashing.ComputeHash256Array(tx.Bytes())), txID)
+	require.Equal(txID, tx.ID())
+
+	// A previously set ID is returned without being recomputed.
+	expectedID := ids.GenerateTestID()
+	tx.TxID = expectedID
+	txID, err = tx.ComputeID()
+	require.NoError(err)
+	require.Equal(expectedID, txID)
+}
+
+type testUTXOGetter map[ids.ID]*avax.UTXO
+
+func (g testUTXOGetter) GetUTXO(utxoID ids.ID) (*avax.UTXO, error) {
+	utxo, ok := g[utxoID]
+	if !ok {
+		return nil, database.ErrNotFound
+	}
+	return utxo, nil
+}
+
+func TestTxVerifySignatures(t *testing.T) {
+	var (
+		ctx  = snowtest.Context(t, snowtest.PChainID)
+		keys = secp256k1.TestKeys()
+		key  = keys[0]
+
+		utxoID = avax.UTXOID{TxID: ids.GenerateTestID()}
+	)
+	newTx := func(t *testing.T, sigIndices []uint32, signers ...*secp256k1.PrivateKey) *Tx {
+		utx := &BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    ctx.NetworkID,
+			BlockchainID: ctx.ChainID,
+			Ins: []*avax.TransferableInput{{
+				UTXOID: utxoID,
+				Asset:  avax.Asset{ID: ctx.AVAXAssetID},
+				In: &secp256k1fx.TransferInput{
+					Amt:   1,
+					Input: secp256k1fx.Input{SigIndices: sigIndices},
+				},
+			}},
+		}}
+		tx, err := NewSigned(utx, Codec, [][]*secp256k1.PrivateKey{signers})
+		require.NoError(t, err)
+		return tx
+	}
+	newValidTx := func(t *testing.T) *Tx {
+		return newTx(t, []uint32{0}, key)
+	}
+	newUTXOs := func(threshold uint32, addrs ...ids.ShortID) testUTXOGetter {
+		return testUTXOGetter{
+			utxoID.InputID(): {
+				UTXOID: utxoID,
+				Asset:  avax.Asset{ID: ctx.AVAXAssetID},
+				Out: &secp256k1fx.TransferOutput{
+					Amt: 1,
+					OutputOwners: secp256k1fx.OutputOwners{
+						Threshold: threshold,
+						Addrs:     addrs,
+					},
+				},
+			},
+		}
+	}
+	// tamper modifies a single byte of the signature of the first credential.
+	// The signature remains recoverable, but to a different public key.
+	tamper := func(t *testing.T) *Tx {
+		tx := newValidTx(t)
+		cred := tx.Creds[0].(*secp256k1fx.Credential)
+		cred.Sigs[0][secp256k1.SignatureLen-2] ^= 0x01
+		return tx
+	}
+
+	tests := []struct {
+		name        string
+		txF         func(*testing.T) *Tx
+		utxos       avax.UTXOGetter
+		expectedErr error
+	}{
+		{
+			name:        "valid",
+			txF:         newValidTx,
+			expectedErr: nil,
+		},
+		{
+			name:        "valid with owners",
+			txF:         newValidTx,
+			utxos:       newUTXOs(1, key.Address()),
+			expectedErr: nil,
+		},
+		{
+			name:        "valid with unknown utxo",
+			txF:         newValidTx,
+			utxos:       testUTXOGetter{},
+			expectedErr: nil,
+		},
+		{
+			name:        "valid multisig with owners",
+			txF:         func(t *testing.T) *Tx { return newTx(t, []uint32{0, 1}, keys[1], key) },
+			utxos:       newUTXOs(2, keys[1].Address(), key.Address()),
+			expectedErr: nil,
+		},
+		{
+			name: "uninitialized",
+			txF: func(*testing.T) *Tx {
+				return &Tx{}
+			},
+			expectedErr: errSignedTxNotInitialized,
+		},
+		{
+			name: "unrecoverable signature",
+			txF: func(t *testing.T) *Tx {
+				tx := newValidTx(t)
+				cred := tx.Creds[0].(*secp256k1fx.Credential)
+				cred.Sigs[0] = [secp256k1.SignatureLen]byte{}
+				return tx
+			},
+			expectedErr: ErrInvalidSignature,
+		},
+		{
+			// Without the owners of the UTXO, a signature from the wrong key
+			// can't be detected.
+			name:        "tampered signature without owners",
+			txF:         tamper,
+			expectedErr: nil,
+		},
+		{
+			name:        "tampered signature",
+			txF:         tamper,
+			utxos:       newUTXOs(1, key.Address()),
+			expectedErr: secp256k1fx.ErrWrongSig,
+		},
+		{
+			name:        "wrong signer",
+			txF:         func(t *testing.T) *Tx { return newTx(t, []uint32{0}, keys[1]) },
+			utxos:       newUTXOs(1, key.Address()),
+			expectedErr: secp256k1fx.ErrWrongSig,
+		},
+		{
+			name:        "signature index out of bounds",
+			txF:         func(t *testing.T) *Tx { return newTx(t, []uint32{1}, key) },
+			utxos:       newUTXOs(1, key.Address()),
+			expectedErr: secp256k1fx.ErrInputOutputIndexOutOfBounds,
+		},
+		{
+			name:        "too few signers",
+			txF:         newValidTx,
+			utxos:       newUTXOs(2, key.Address(), keys[1].Address()),
+			expectedErr: secp256k1fx.ErrTooFewSigners,
+		},
+		{
+			name:        "fewer signatures than signature indices",
+			txF:         func(t *testing.T) *Tx { return newTx(t, []uint32{0, 1}, key) },
+			expectedErr: secp256k1fx.ErrInputCredentialSignersMismatch,
+		},
+		{
+			name:        "more signatures than signature indices",
+			txF:         func(t *testing.T) *Tx { return newTx(t, []uint32{0}, key, keys[1]) },
+			expectedErr: secp256k1fx.ErrInputCredentialSignersMismatch,
+		},
+		{
+			name: "missing credential",
+			txF: func(t *testing.T) *Tx {
+				tx := newValidTx(t)
+				tx.Creds = nil
+				return tx
+			},
+			expectedErr: ErrWrongNumberOfCredentials,
+		},
+		{
+			name: "extra credential",
+			txF: func(t *testing.T) *Tx {
+				tx := newValidTx(t)
+				tx.Creds = append(tx.Creds, &secp256k1fx.Credential{})
+				return tx
+			},
+			expectedErr: ErrWrongNumberOfCredentials,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tx := test.txF(t)
+			err := tx.VerifySignaturesWithUTXOs(ctx, test.utxos)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
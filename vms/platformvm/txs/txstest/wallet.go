@@ -10,13 +10,16 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
 	"github.com/ava-labs/avalanchego/vms/platformvm/config"
 	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+	"github.com/ava-labs/avalanchego/vms/platformvm/status"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/platformvm/warp/message"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
@@ -103,7 +106,9 @@ func NewWallet(
 	)
 	return wallet.New(
 		&client{
-			backend: backend,
+			backend:   backend,
+			state:     state,
+			feeConfig: config.DynamicFeeConfig,
 		},
 		builder.New(
 			addrs,
@@ -118,7 +123,9 @@ func NewWallet(
 }
 
 type client struct {
-	backend wallet.Backend
+	backend   wallet.Backend
+	state     state.State
+	feeConfig gas.Config
 }
 
 func (c *client) IssueTx(
@@ -133,3 +140,15 @@ func (c *client) IssueTx(
 	ctx := ops.Context()
 	return c.backend.AcceptTx(ctx, tx)
 }
+
+func (c *client) GetTxStatus(_ context.Context, txID ids.ID) (status.Status, error) {
+	_, txStatus, err := c.state.GetTx(txID)
+	if err == database.ErrNotFound {
+		return status.Unknown, nil
+	}
+	return txStatus, err
+}
+
+func (c *client) GetFeeConfig(context.Context) (*gas.Config, error) {
+	return &c.feeConfig, nil
+}
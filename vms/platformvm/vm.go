@@ -165,9 +165,16 @@ func (vm *VM) Initialize(
 		Uptimes:      vm.uptimeManager,
 		Rewards:      rewards,
 		Bootstrapped: &vm.bootstrapped,
+		Tracer:       vm.Internal.Tracer,
 	}
 
-	mempool, err := pmempool.New("mempool", registerer, toEngine)
+	mempool, err := pmempool.New(
+		"mempool",
+		vm.Internal.DynamicFeeConfig.Weights,
+		registerer,
+		toEngine,
+		pmempool.WithUTXOGetter(state.NewAcceptedUTXOGetter(vm.db)),
+	)
 	if err != nil {
 		return fmt.Errorf("failed to create mempool: %w", err)
 	}
@@ -251,6 +258,14 @@ func (vm *VM) periodicallyPruneMempool(frequency time.Duration) {
 					zap.Error(err),
 				)
 			}
+
+			// Drop reasons that have been tracked for a full pruning period
+			// are unlikely to still be queried.
+			if numRejected := vm.Builder.RejectExpiredDropReasons(frequency); numRejected > 0 {
+				vm.ctx.Log.Debug("rejected expired mempool drop reasons",
+					zap.Int("numRejected", numRejected),
+				)
+			}
 		}
 	}
 }
@@ -47,7 +47,7 @@ func (s *chainState) DeleteLastAccepted() error {
 }
 
 func (s *chainState) GetLastAccepted() (ids.ID, error) {
-	if s.lastAccepted != ids.Empty {
+	if !s.lastAccepted.IsZero() {
 		return s.lastAccepted, nil
 	}
 	lastAcceptedBytes, err := s.db.Get(lastAcceptedKey)
@@ -723,9 +723,7 @@ func (vm *VM) verifyAndRecordInnerBlk(ctx context.Context, blockCtx *block.Conte
 // notifyInnerBlockReady tells the scheduler that the inner VM is ready to build
 // a new block
 func (vm *VM) notifyInnerBlockReady() {
-	select {
-	case vm.toScheduler <- common.PendingTxs:
-	default:
+	if !common.DrainToEngine(vm.toScheduler, common.PendingTxs) {
 		vm.ctx.Log.Debug("dropping message to consensus engine")
 	}
 }
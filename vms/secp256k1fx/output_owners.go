@@ -93,6 +93,12 @@ func (out *OutputOwners) AddressesSet() set.Set[ids.ShortID] {
 	return set.Of(out.Addrs...)
 }
 
+// UnlockTime returns the locktime of the output, as outputs can't be spent
+// before their locktime.
+func (out *OutputOwners) UnlockTime() uint64 {
+	return out.Locktime
+}
+
 // Equals returns true if the provided owners create the same condition
 func (out *OutputOwners) Equals(other *OutputOwners) bool {
 	if out == other {
@@ -57,6 +57,10 @@ type Mempool[T Tx] interface {
 	// Iterate iterates over the txs until f returns false
 	Iterate(f func(tx T) bool)
 
+	// GetConsumers returns the IDs of the txs in the mempool that consume any
+	// of [utxoIDs].
+	GetConsumers(utxoIDs set.Set[ids.ID]) set.Set[ids.ID]
+
 	// Note: dropped txs are added to droppedTxIDs but are not evicted from
 	// unissued decision/staker txs. This allows previously dropped txs to be
 	// possibly reissued.
@@ -192,6 +196,19 @@ func (m *mempool[T]) Iterate(f func(T) bool) {
 	}
 }
 
+func (m *mempool[_]) GetConsumers(utxoIDs set.Set[ids.ID]) set.Set[ids.ID] {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	var txIDs set.Set[ids.ID]
+	for utxoID := range utxoIDs {
+		if txID, ok := m.consumedUTXOs.GetKey(utxoID); ok {
+			txIDs.Add(txID)
+		}
+	}
+	return txIDs
+}
+
 func (m *mempool[_]) MarkDropped(txID ids.ID, reason error) {
 	if errors.Is(reason, ErrMempoolFull) {
 		return
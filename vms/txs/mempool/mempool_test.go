@@ -191,6 +191,28 @@ func TestRemoveConflict(t *testing.T) {
 	require.False(exists)
 }
 
+func TestGetConsumers(t *testing.T) {
+	require := require.New(t)
+
+	mempool := newMempool()
+
+	tx0 := newTx(0, 32)
+	tx1 := newTx(1, 32)
+	require.NoError(mempool.Add(tx0))
+	require.NoError(mempool.Add(tx1))
+
+	require.Empty(mempool.GetConsumers(nil))
+	require.Empty(mempool.GetConsumers(set.Of(ids.Empty.Prefix(2))))
+	require.Equal(set.Of(tx0.ID()), mempool.GetConsumers(tx0.InputIDs()))
+	require.Equal(
+		set.Of(tx0.ID(), tx1.ID()),
+		mempool.GetConsumers(set.Of(ids.Empty.Prefix(0), ids.Empty.Prefix(1), ids.Empty.Prefix(2))),
+	)
+
+	mempool.Remove(tx0)
+	require.Empty(mempool.GetConsumers(tx0.InputIDs()))
+}
+
 func TestIterate(t *testing.T) {
 	require := require.New(t)
 
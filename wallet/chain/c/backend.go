@@ -14,6 +14,7 @@ import (
 
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/utils/math"
+	"github.com/ava-labs/avalanchego/utils/units"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
 
@@ -76,8 +77,7 @@ func (b *backend) AcceptAtomicTx(ctx context.Context, tx *atomic.Tx) error {
 				continue
 			}
 
-			balance := new(big.Int).SetUint64(output.Amount)
-			balance.Mul(balance, avaxConversionRate)
+			balance := units.NanoAvaxToWei(output.Amount)
 			account.Balance.Add(account.Balance, balance)
 		}
 	case *atomic.UnsignedExportTx:
@@ -109,8 +109,7 @@ func (b *backend) AcceptAtomicTx(ctx context.Context, tx *atomic.Tx) error {
 				continue
 			}
 
-			balance := new(big.Int).SetUint64(input.Amount)
-			balance.Mul(balance, avaxConversionRate)
+			balance := units.NanoAvaxToWei(input.Amount)
 			if account.Balance.Cmp(balance) == -1 {
 				return errInsufficientFunds
 			}
@@ -14,6 +14,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/math"
 	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/units"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
@@ -21,21 +22,10 @@ import (
 	ethcommon "github.com/ethereum/go-ethereum/common"
 )
 
-const avaxConversionRateInt = 1_000_000_000
-
 var (
 	_ Builder = (*builder)(nil)
 
 	errInsufficientFunds = errors.New("insufficient funds")
-
-	// avaxConversionRate is the conversion rate between the smallest
-	// denomination on the X-Chain and P-chain, 1 nAVAX, and the smallest
-	// denomination on the C-Chain 1 wei. Where 1 nAVAX = 1 gWei.
-	//
-	// This is only required for AVAX because the denomination of 1 AVAX is 9
-	// decimal places on the X and P chains, but is 18 decimal places within the
-	// EVM.
-	avaxConversionRate = big.NewInt(avaxConversionRateInt)
 )
 
 // Builder provides a convenient interface for building unsigned C-chain
@@ -345,10 +335,12 @@ func (b *builder) NewExportTx(
 			return nil, err
 		}
 
-		// Since the asset is AVAX, we divide by the avaxConversionRate to
-		// convert back to the correct denomination of AVAX that can be
-		// exported.
-		avaxBalance := new(big.Int).Div(balance, avaxConversionRate).Uint64()
+		// Since the asset is AVAX, we convert back to the correct denomination
+		// of AVAX that can be exported.
+		avaxBalance, err := units.WeiToNanoAvax(balance)
+		if err != nil {
+			return nil, err
+		}
 
 		// If the balance for [addr] is insufficient to cover the additional
 		// cost of adding an input to the transaction, skip adding the input
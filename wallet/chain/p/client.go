@@ -4,13 +4,33 @@
 package p
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
 	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/status"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/wallet/chain/p/wallet"
 	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
 )
 
-var _ wallet.Client = (*Client)(nil)
+// feeConfigCacheDuration is how long a fetched fee config is reused before it
+// is fetched again.
+const feeConfigCacheDuration = 30 * time.Second
+
+var (
+	_ wallet.Client = (*Client)(nil)
+
+	ErrTxNotPending = errors.New("tx is not pending")
+	ErrTxDropped    = errors.New("tx was dropped")
+)
 
 func NewClient(
 	c platformvm.Client,
@@ -25,8 +45,20 @@ func NewClient(
 type Client struct {
 	client  platformvm.Client
 	backend wallet.Backend
+	clock   mockable.Clock
+
+	feeConfigLock      sync.Mutex
+	feeConfig          *gas.Config
+	feeConfigFetchTime time.Time
 }
 
+// IssueTx issues [tx] and, unless the tx is assumed to be decided, waits for it
+// to be committed or aborted before marking it as accepted in the backend.
+//
+// By default, a tx that is reported as dropped is waited on until it is
+// decided or the context is done, as a dropped tx may still be accepted later.
+// If the common.WithFailOnDropped option is provided, an error wrapping
+// ErrTxDropped is returned as soon as the tx is reported as dropped.
 func (c *Client) IssueTx(
 	tx *txs.Tx,
 	options ...common.Option,
@@ -46,9 +78,118 @@ func (c *Client) IssueTx(
 		return c.backend.AcceptTx(ctx, tx)
 	}
 
-	if err := platformvm.AwaitTxAccepted(c.client, ctx, txID, ops.PollFrequency()); err != nil {
+	if err := c.awaitTxDecided(ctx, txID, ops.PollFrequency(), ops.FailOnDropped()); err != nil {
 		return err
 	}
 
 	return c.backend.AcceptTx(ctx, tx)
 }
+
+func (c *Client) GetTxStatus(ctx context.Context, txID ids.ID) (status.Status, error) {
+	res, err := c.client.GetTxStatus(ctx, txID)
+	if err != nil {
+		return status.Unknown, err
+	}
+	return res.Status, nil
+}
+
+// GetFeeConfig returns the dynamic fee config of the P-chain. The config is
+// cached for [feeConfigCacheDuration].
+func (c *Client) GetFeeConfig(ctx context.Context) (*gas.Config, error) {
+	c.feeConfigLock.Lock()
+	defer c.feeConfigLock.Unlock()
+
+	now := c.clock.Time()
+	if c.feeConfig != nil && now.Sub(c.feeConfigFetchTime) < feeConfigCacheDuration {
+		return c.feeConfig, nil
+	}
+
+	feeConfig, err := c.client.GetFeeConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.feeConfig = feeConfig
+	c.feeConfigFetchTime = now
+	return feeConfig, nil
+}
+
+// GetUTXOs returns up to [limit] UTXOs controlled by [addr]. If [limit] is 0,
+// the API's default limit is used.
+func (c *Client) GetUTXOs(ctx context.Context, addr ids.ShortID, limit uint32) ([]*avax.UTXO, error) {
+	utxosBytes, _, _, err := c.client.GetUTXOs(
+		ctx,
+		[]ids.ShortID{addr},
+		limit,
+		ids.ShortEmpty,
+		ids.Empty,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	utxos := make([]*avax.UTXO, len(utxosBytes))
+	for i, utxoBytes := range utxosBytes {
+		utxo := &avax.UTXO{}
+		if _, err := txs.Codec.Unmarshal(utxoBytes, utxo); err != nil {
+			return nil, fmt.Errorf("couldn't parse UTXO: %w", err)
+		}
+		utxos[i] = utxo
+	}
+	return utxos, nil
+}
+
+// awaitTxDecided polls the status of [txID] until it is decided. If
+// [failOnDropped] is true and the tx is dropped from the mempool, ErrTxDropped
+// is returned rather than waiting for [ctx] to expire.
+func (c *Client) awaitTxDecided(
+	ctx context.Context,
+	txID ids.ID,
+	freq time.Duration,
+	failOnDropped bool,
+) error {
+	if !failOnDropped {
+		return platformvm.AwaitTxAccepted(c.client, ctx, txID, freq)
+	}
+
+	ticker := time.NewTicker(freq)
+	defer ticker.Stop()
+
+	for {
+		txStatus, err := c.GetTxStatus(ctx, txID)
+		if err != nil {
+			return err
+		}
+
+		switch txStatus {
+		case status.Committed, status.Aborted:
+			return nil
+		case status.Dropped:
+			return fmt.Errorf("%w: %s", ErrTxDropped, txID)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// GetPendingTx returns the tx with the provided ID if it is still processing.
+// If the tx has been decided, or isn't known by the node, ErrTxNotPending is
+// returned.
+func (c *Client) GetPendingTx(ctx context.Context, txID ids.ID) (*txs.Tx, error) {
+	txStatus, err := c.client.GetTxStatus(ctx, txID)
+	if err != nil {
+		return nil, err
+	}
+	if txStatus.Status != status.Processing {
+		return nil, fmt.Errorf("%w: %s has status %s", ErrTxNotPending, txID, txStatus.Status)
+	}
+
+	txBytes, err := c.client.GetPendingTx(ctx, txID)
+	if err != nil {
+		return nil, err
+	}
+	return txs.Parse(txs.Codec, txBytes)
+}
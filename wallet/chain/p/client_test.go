@@ -0,0 +1,267 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package p
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/rpc"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/status"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+// testPClient implements the subset of platformvm.Client used by Client.
+type testPClient struct {
+	platformvm.Client
+
+	txs      map[ids.ID]*txs.Tx
+	statuses map[ids.ID]status.Status
+	utxos    map[ids.ShortID][][]byte
+
+	feeConfig            *gas.Config
+	numFeeConfigRequests int
+}
+
+func (c *testPClient) GetFeeConfig(context.Context, ...rpc.Option) (*gas.Config, error) {
+	c.numFeeConfigRequests++
+	return c.feeConfig, nil
+}
+
+func (c *testPClient) GetUTXOs(
+	_ context.Context,
+	addrs []ids.ShortID,
+	limit uint32,
+	_ ids.ShortID,
+	_ ids.ID,
+	_ ...rpc.Option,
+) ([][]byte, ids.ShortID, ids.ID, error) {
+	var utxos [][]byte
+	for _, addr := range addrs {
+		utxos = append(utxos, c.utxos[addr]...)
+	}
+	if limit != 0 && len(utxos) > int(limit) {
+		utxos = utxos[:limit]
+	}
+	return utxos, ids.ShortEmpty, ids.Empty, nil
+}
+
+func (c *testPClient) GetPendingTx(_ context.Context, txID ids.ID, _ ...rpc.Option) ([]byte, error) {
+	tx, ok := c.txs[txID]
+	if !ok {
+		return nil, database.ErrNotFound
+	}
+	return tx.Bytes(), nil
+}
+
+func (c *testPClient) GetTxStatus(_ context.Context, txID ids.ID, _ ...rpc.Option) (*platformvm.GetTxStatusResponse, error) {
+	txStatus, ok := c.statuses[txID]
+	if !ok {
+		txStatus = status.Unknown
+	}
+	return &platformvm.GetTxStatusResponse{
+		Status: txStatus,
+	}, nil
+}
+
+func newTestTx(t *testing.T) *txs.Tx {
+	tx := &txs.Tx{Unsigned: &txs.BaseTx{BaseTx: avax.BaseTx{
+		NetworkID:    constants.UnitTestID,
+		BlockchainID: ids.GenerateTestID(),
+	}}}
+	require.NoError(t, tx.Initialize(txs.Codec))
+	return tx
+}
+
+func TestClientGetPendingTx(t *testing.T) {
+	var (
+		processingTx = newTestTx(t)
+		committedTx  = newTestTx(t)
+		unknownTxID  = ids.GenerateTestID()
+		client       = NewClient(
+			&testPClient{
+				txs: map[ids.ID]*txs.Tx{
+					processingTx.ID(): processingTx,
+					committedTx.ID():  committedTx,
+				},
+				statuses: map[ids.ID]status.Status{
+					processingTx.ID(): status.Processing,
+					committedTx.ID():  status.Committed,
+				},
+			},
+			nil,
+		)
+	)
+
+	tests := []struct {
+		name        string
+		txID        ids.ID
+		expectedTx  *txs.Tx
+		expectedErr error
+	}{
+		{
+			name:       "processing",
+			txID:       processingTx.ID(),
+			expectedTx: processingTx,
+		},
+		{
+			name:        "committed",
+			txID:        committedTx.ID(),
+			expectedErr: ErrTxNotPending,
+		},
+		{
+			name:        "unknown",
+			txID:        unknownTxID,
+			expectedErr: ErrTxNotPending,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			tx, err := client.GetPendingTx(context.Background(), test.txID)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				return
+			}
+			require.Equal(test.expectedTx.ID(), tx.ID())
+			require.Equal(test.expectedTx.Bytes(), tx.Bytes())
+		})
+	}
+}
+
+func TestClientGetTxStatus(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		committedTxID = ids.GenerateTestID()
+		droppedTxID   = ids.GenerateTestID()
+		client        = NewClient(
+			&testPClient{
+				statuses: map[ids.ID]status.Status{
+					committedTxID: status.Committed,
+					droppedTxID:   status.Dropped,
+				},
+			},
+			nil,
+		)
+		ctx = context.Background()
+	)
+
+	txStatus, err := client.GetTxStatus(ctx, committedTxID)
+	require.NoError(err)
+	require.Equal(status.Committed, txStatus)
+
+	txStatus, err = client.GetTxStatus(ctx, droppedTxID)
+	require.NoError(err)
+	require.Equal(status.Dropped, txStatus)
+
+	require.NoError(client.awaitTxDecided(ctx, committedTxID, time.Millisecond, false))
+
+	// By default, a dropped tx is waited on until the context is done.
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	err = client.awaitTxDecided(timeoutCtx, droppedTxID, time.Millisecond, false)
+	require.ErrorIs(err, context.DeadlineExceeded)
+
+	err = client.awaitTxDecided(ctx, droppedTxID, time.Millisecond, true)
+	require.ErrorIs(err, ErrTxDropped)
+}
+
+func TestClientGetUTXOs(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		addr       = ids.GenerateTestShortID()
+		utxos      = make([]*avax.UTXO, 3)
+		utxosBytes = make([][]byte, len(utxos))
+	)
+	for i := range utxos {
+		utxos[i] = &avax.UTXO{
+			UTXOID: avax.UTXOID{
+				TxID:        ids.GenerateTestID(),
+				OutputIndex: uint32(i),
+			},
+			Asset: avax.Asset{ID: ids.GenerateTestID()},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: uint64(i + 1),
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{addr},
+				},
+			},
+		}
+
+		var err error
+		utxosBytes[i], err = txs.Codec.Marshal(txs.CodecVersion, utxos[i])
+		require.NoError(err)
+	}
+
+	client := NewClient(
+		&testPClient{
+			utxos: map[ids.ShortID][][]byte{
+				addr: utxosBytes,
+			},
+		},
+		nil,
+	)
+
+	ctx := context.Background()
+	fetchedUTXOs, err := client.GetUTXOs(ctx, addr, 0)
+	require.NoError(err)
+	require.Equal(utxos, fetchedUTXOs)
+
+	fetchedUTXOs, err = client.GetUTXOs(ctx, addr, 2)
+	require.NoError(err)
+	require.Equal(utxos[:2], fetchedUTXOs)
+
+	fetchedUTXOs, err = client.GetUTXOs(ctx, ids.GenerateTestShortID(), 0)
+	require.NoError(err)
+	require.Empty(fetchedUTXOs)
+}
+
+func TestClientGetFeeConfig(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		pClient = &testPClient{
+			feeConfig: &gas.Config{
+				MaxCapacity: 1_000,
+				MinPrice:    1,
+			},
+		}
+		client = NewClient(pClient, nil)
+		ctx    = context.Background()
+		now    = time.Now()
+	)
+	client.clock.Set(now)
+
+	feeConfig, err := client.GetFeeConfig(ctx)
+	require.NoError(err)
+	require.Equal(pClient.feeConfig, feeConfig)
+	require.Equal(1, pClient.numFeeConfigRequests)
+
+	// The cached config should be used until it expires
+	client.clock.Set(now.Add(feeConfigCacheDuration - time.Second))
+	feeConfig, err = client.GetFeeConfig(ctx)
+	require.NoError(err)
+	require.Equal(pClient.feeConfig, feeConfig)
+	require.Equal(1, pClient.numFeeConfigRequests)
+
+	client.clock.Set(now.Add(feeConfigCacheDuration))
+	feeConfig, err = client.GetFeeConfig(ctx)
+	require.NoError(err)
+	require.Equal(pClient.feeConfig, feeConfig)
+	require.Equal(2, pClient.numFeeConfigRequests)
+}
@@ -4,11 +4,14 @@
 package wallet
 
 import (
+	"context"
 	"time"
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
+	"github.com/ava-labs/avalanchego/vms/platformvm/status"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 	"github.com/ava-labs/avalanchego/wallet/chain/p/builder"
@@ -26,6 +29,12 @@ type Client interface {
 		tx *txs.Tx,
 		options ...common.Option,
 	) error
+
+	// GetTxStatus returns the status of the tx with the provided ID.
+	GetTxStatus(ctx context.Context, txID ids.ID) (status.Status, error)
+
+	// GetFeeConfig returns the current dynamic fee config of the chain.
+	GetFeeConfig(ctx context.Context) (*gas.Config, error)
 }
 
 type Wallet interface {
@@ -4,11 +4,14 @@
 package wallet
 
 import (
+	"context"
 	"time"
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
+	"github.com/ava-labs/avalanchego/vms/platformvm/status"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 	"github.com/ava-labs/avalanchego/wallet/chain/p/builder"
@@ -319,3 +322,11 @@ func (w *withOptions) IssueTx(
 		common.UnionOptions(w.options, options)...,
 	)
 }
+
+func (w *withOptions) GetTxStatus(ctx context.Context, txID ids.ID) (status.Status, error) {
+	return w.wallet.GetTxStatus(ctx, txID)
+}
+
+func (w *withOptions) GetFeeConfig(ctx context.Context) (*gas.Config, error) {
+	return w.wallet.GetFeeConfig(ctx)
+}
@@ -45,6 +45,8 @@ type Options struct {
 
 	assumeDecided bool
 
+	failOnDropped bool
+
 	pollFrequencySet bool
 	pollFrequency    time.Duration
 
@@ -125,6 +127,10 @@ func (o *Options) AssumeDecided() bool {
 	return o.assumeDecided
 }
 
+func (o *Options) FailOnDropped() bool {
+	return o.failOnDropped
+}
+
 func (o *Options) PollFrequency() time.Duration {
 	if o.pollFrequencySet {
 		return o.pollFrequency
@@ -193,6 +199,15 @@ func WithAssumeDecided() Option {
 	}
 }
 
+// WithFailOnDropped causes issuance to return an error as soon as the tx is
+// reported as dropped, rather than waiting for the tx to be decided. A dropped
+// tx may still be accepted later if it is re-issued.
+func WithFailOnDropped() Option {
+	return func(o *Options) {
+		o.failOnDropped = true
+	}
+}
+
 func WithPollFrequency(pollFrequency time.Duration) Option {
 	return func(o *Options) {
 		o.pollFrequencySet = true
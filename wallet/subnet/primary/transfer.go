@@ -0,0 +1,149 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package primary
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+)
+
+var (
+	ErrSameChain    = errors.New("source and destination chains are the same")
+	ErrUnknownChain = errors.New("unknown chain")
+)
+
+// Chain identifies one of the chains of the primary network.
+type Chain byte
+
+const (
+	PChain Chain = iota
+	XChain
+	CChain
+)
+
+func (c Chain) String() string {
+	switch c {
+	case PChain:
+		return "P"
+	case XChain:
+		return "X"
+	case CChain:
+		return "C"
+	default:
+		return "unknown"
+	}
+}
+
+// MultiChainTransfer moves [amount] of AVAX from [from] to [to] by issuing an
+// export tx on [from] followed by an import tx on [to]. The imported funds are
+// sent to the address of [key]. Each tx is waited on before the next one is
+// issued.
+//
+// The import tx consumes all the UTXOs exported from [from] to the address of
+// [key], so the wallet's keychain must include [key].
+func (w *Wallet) MultiChainTransfer(
+	ctx context.Context,
+	from Chain,
+	to Chain,
+	amount uint64,
+	key *secp256k1.PrivateKey,
+) error {
+	if from == to {
+		return fmt.Errorf("%w: %s", ErrSameChain, from)
+	}
+	fromChainID, err := w.chainID(from)
+	if err != nil {
+		return err
+	}
+	toChainID, err := w.chainID(to)
+	if err != nil {
+		return err
+	}
+
+	var (
+		options = []common.Option{common.WithContext(ctx)}
+		owner   = secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{key.Address()},
+		}
+	)
+	switch from {
+	case PChain:
+		_, err = w.p.IssueExportTx(
+			toChainID,
+			[]*avax.TransferableOutput{
+				exportOutput(w.p.Builder().Context().AVAXAssetID, amount, owner),
+			},
+			options...,
+		)
+	case XChain:
+		_, err = w.x.IssueExportTx(
+			toChainID,
+			[]*avax.TransferableOutput{
+				exportOutput(w.x.Builder().Context().AVAXAssetID, amount, owner),
+			},
+			options...,
+		)
+	case CChain:
+		_, err = w.c.IssueExportTx(
+			toChainID,
+			[]*secp256k1fx.TransferOutput{{
+				Amt:          amount,
+				OutputOwners: owner,
+			}},
+			options...,
+		)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to export from %s-chain: %w", from, err)
+	}
+
+	switch to {
+	case PChain:
+		_, err = w.p.IssueImportTx(fromChainID, &owner, options...)
+	case XChain:
+		_, err = w.x.IssueImportTx(fromChainID, &owner, options...)
+	case CChain:
+		_, err = w.c.IssueImportTx(fromChainID, key.EthAddress(), options...)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to import to %s-chain: %w", to, err)
+	}
+	return nil
+}
+
+func (w *Wallet) chainID(chain Chain) (ids.ID, error) {
+	switch chain {
+	case PChain:
+		return constants.PlatformChainID, nil
+	case XChain:
+		return w.x.Builder().Context().BlockchainID, nil
+	case CChain:
+		return w.c.Builder().Context().BlockchainID, nil
+	default:
+		return ids.Empty, fmt.Errorf("%w: %d", ErrUnknownChain, chain)
+	}
+}
+
+func exportOutput(
+	avaxAssetID ids.ID,
+	amount uint64,
+	owner secp256k1fx.OutputOwners,
+) *avax.TransferableOutput {
+	return &avax.TransferableOutput{
+		Asset: avax.Asset{ID: avaxAssetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt:          amount,
+			OutputOwners: owner,
+		},
+	}
+}
@@ -0,0 +1,293 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package primary
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/ava-labs/coreth/plugin/evm/atomic"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/chain/c"
+	"github.com/ava-labs/avalanchego/wallet/chain/x"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+
+	avmtxs "github.com/ava-labs/avalanchego/vms/avm/txs"
+	pbuilder "github.com/ava-labs/avalanchego/wallet/chain/p/builder"
+	pwallet "github.com/ava-labs/avalanchego/wallet/chain/p/wallet"
+	xbuilder "github.com/ava-labs/avalanchego/wallet/chain/x/builder"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// issuedTx records an export or import tx issued by a test wallet.
+type issuedTx struct {
+	chain    Chain
+	isExport bool
+	// Destination chain of exports and source chain of imports
+	peerChainID ids.ID
+	amount      uint64
+	addr        ids.ShortID
+	ethAddr     ethcommon.Address
+}
+
+type testPWallet struct {
+	pwallet.Wallet
+	context *pbuilder.Context
+	issued  *[]issuedTx
+}
+
+type testPBuilder struct {
+	pbuilder.Builder
+	context *pbuilder.Context
+}
+
+func (b *testPBuilder) Context() *pbuilder.Context {
+	return b.context
+}
+
+func (w *testPWallet) Builder() pbuilder.Builder {
+	return &testPBuilder{context: w.context}
+}
+
+func (w *testPWallet) IssueExportTx(
+	chainID ids.ID,
+	outputs []*avax.TransferableOutput,
+	_ ...common.Option,
+) (*txs.Tx, error) {
+	out := outputs[0].Out.(*secp256k1fx.TransferOutput)
+	*w.issued = append(*w.issued, issuedTx{
+		chain:       PChain,
+		isExport:    true,
+		peerChainID: chainID,
+		amount:      out.Amt,
+		addr:        out.Addrs[0],
+	})
+	return nil, nil
+}
+
+func (w *testPWallet) IssueImportTx(
+	chainID ids.ID,
+	to *secp256k1fx.OutputOwners,
+	_ ...common.Option,
+) (*txs.Tx, error) {
+	*w.issued = append(*w.issued, issuedTx{
+		chain:       PChain,
+		peerChainID: chainID,
+		addr:        to.Addrs[0],
+	})
+	return nil, nil
+}
+
+type testXWallet struct {
+	x.Wallet
+	context *xbuilder.Context
+	issued  *[]issuedTx
+}
+
+type testXBuilder struct {
+	xbuilder.Builder
+	context *xbuilder.Context
+}
+
+func (b *testXBuilder) Context() *xbuilder.Context {
+	return b.context
+}
+
+func (w *testXWallet) Builder() xbuilder.Builder {
+	return &testXBuilder{context: w.context}
+}
+
+func (w *testXWallet) IssueExportTx(
+	chainID ids.ID,
+	outputs []*avax.TransferableOutput,
+	_ ...common.Option,
+) (*avmtxs.Tx, error) {
+	out := outputs[0].Out.(*secp256k1fx.TransferOutput)
+	*w.issued = append(*w.issued, issuedTx{
+		chain:       XChain,
+		isExport:    true,
+		peerChainID: chainID,
+		amount:      out.Amt,
+		addr:        out.Addrs[0],
+	})
+	return nil, nil
+}
+
+func (w *testXWallet) IssueImportTx(
+	chainID ids.ID,
+	to *secp256k1fx.OutputOwners,
+	_ ...common.Option,
+) (*avmtxs.Tx, error) {
+	*w.issued = append(*w.issued, issuedTx{
+		chain:       XChain,
+		peerChainID: chainID,
+		addr:        to.Addrs[0],
+	})
+	return nil, nil
+}
+
+type testCWallet struct {
+	c.Wallet
+	context *c.Context
+	issued  *[]issuedTx
+}
+
+type testCBuilder struct {
+	c.Builder
+	context *c.Context
+}
+
+func (b *testCBuilder) Context() *c.Context {
+	return b.context
+}
+
+func (w *testCWallet) Builder() c.Builder {
+	return &testCBuilder{context: w.context}
+}
+
+func (w *testCWallet) IssueExportTx(
+	chainID ids.ID,
+	outputs []*secp256k1fx.TransferOutput,
+	_ ...common.Option,
+) (*atomic.Tx, error) {
+	*w.issued = append(*w.issued, issuedTx{
+		chain:       CChain,
+		isExport:    true,
+		peerChainID: chainID,
+		amount:      outputs[0].Amt,
+		addr:        outputs[0].Addrs[0],
+	})
+	return nil, nil
+}
+
+func (w *testCWallet) IssueImportTx(
+	chainID ids.ID,
+	to ethcommon.Address,
+	_ ...common.Option,
+) (*atomic.Tx, error) {
+	*w.issued = append(*w.issued, issuedTx{
+		chain:       CChain,
+		peerChainID: chainID,
+		ethAddr:     to,
+	})
+	return nil, nil
+}
+
+func TestWalletMultiChainTransfer(t *testing.T) {
+	var (
+		key         = secp256k1.TestKeys()[0]
+		avaxAssetID = ids.GenerateTestID()
+		xChainID    = ids.GenerateTestID()
+		cChainID    = ids.GenerateTestID()
+		chainIDs    = map[Chain]ids.ID{
+			PChain: constants.PlatformChainID,
+			XChain: xChainID,
+			CChain: cChainID,
+		}
+		amount = units.Avax
+	)
+
+	chains := []Chain{PChain, XChain, CChain}
+	for _, from := range chains {
+		for _, to := range chains {
+			if from == to {
+				continue
+			}
+
+			t.Run(fmt.Sprintf("%s to %s", from, to), func(t *testing.T) {
+				require := require.New(t)
+
+				var issued []issuedTx
+				wallet := NewWallet(
+					&testPWallet{
+						context: &pbuilder.Context{AVAXAssetID: avaxAssetID},
+						issued:  &issued,
+					},
+					&testXWallet{
+						context: &xbuilder.Context{
+							BlockchainID: xChainID,
+							AVAXAssetID:  avaxAssetID,
+						},
+						issued: &issued,
+					},
+					&testCWallet{
+						context: &c.Context{
+							BlockchainID: cChainID,
+							AVAXAssetID:  avaxAssetID,
+						},
+						issued: &issued,
+					},
+				)
+				require.NoError(wallet.MultiChainTransfer(context.Background(), from, to, amount, key))
+
+				expectedImport := issuedTx{
+					chain:       to,
+					peerChainID: chainIDs[from],
+				}
+				if to == CChain {
+					expectedImport.ethAddr = key.EthAddress()
+				} else {
+					expectedImport.addr = key.Address()
+				}
+				require.Equal(
+					[]issuedTx{
+						{
+							chain:       from,
+							isExport:    true,
+							peerChainID: chainIDs[to],
+							amount:      amount,
+							addr:        key.Address(),
+						},
+						expectedImport,
+					},
+					issued,
+				)
+			})
+		}
+	}
+}
+
+func TestWalletMultiChainTransferInvalidChains(t *testing.T) {
+	tests := []struct {
+		name        string
+		from        Chain
+		to          Chain
+		expectedErr error
+	}{
+		{
+			name:        "same chain",
+			from:        PChain,
+			to:          PChain,
+			expectedErr: ErrSameChain,
+		},
+		{
+			name:        "unknown chain",
+			from:        PChain,
+			to:          CChain + 1,
+			expectedErr: ErrUnknownChain,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			wallet := NewWallet(nil, nil, nil)
+			err := wallet.MultiChainTransfer(
+				context.Background(),
+				test.from,
+				test.to,
+				units.Avax,
+				secp256k1.TestKeys()[0],
+			)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
@@ -5,11 +5,14 @@ package primary
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/keychain"
 	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs/fee"
 	"github.com/ava-labs/avalanchego/wallet/chain/c"
 	"github.com/ava-labs/avalanchego/wallet/chain/p"
 	"github.com/ava-labs/avalanchego/wallet/chain/x"
@@ -41,6 +44,26 @@ func (w *Wallet) C() c.Wallet {
 	return w.c
 }
 
+// EstimateFee returns the fee that the P-chain wallet would charge to issue
+// [utx]. The complexity of [utx] is weighted by the current fee config of the
+// P-chain and priced at the gas price of the wallet's builder context.
+func (w *Wallet) EstimateFee(ctx context.Context, utx txs.UnsignedTx) (uint64, error) {
+	feeConfig, err := w.p.GetFeeConfig(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch fee config: %w", err)
+	}
+
+	complexity, err := fee.TxComplexity(utx)
+	if err != nil {
+		return 0, err
+	}
+	txGas, err := complexity.ToGas(feeConfig.Weights)
+	if err != nil {
+		return 0, err
+	}
+	return txGas.Cost(w.p.Builder().Context().GasPrice)
+}
+
 // Creates a new default wallet
 func NewWallet(p pwallet.Wallet, x x.Wallet, c c.Wallet) *Wallet {
 	return &Wallet{
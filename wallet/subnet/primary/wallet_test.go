@@ -0,0 +1,115 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package primary
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
+	"github.com/ava-labs/avalanchego/vms/platformvm/status"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common/utxotest"
+
+	pbuilder "github.com/ava-labs/avalanchego/wallet/chain/p/builder"
+	pwallet "github.com/ava-labs/avalanchego/wallet/chain/p/wallet"
+)
+
+var _ pwallet.Client = (*testPClient)(nil)
+
+type testPClient struct {
+	feeConfig *gas.Config
+}
+
+func (*testPClient) IssueTx(*txs.Tx, ...common.Option) error {
+	return nil
+}
+
+func (*testPClient) GetTxStatus(context.Context, ids.ID) (status.Status, error) {
+	return status.Unknown, nil
+}
+
+func (c *testPClient) GetFeeConfig(context.Context) (*gas.Config, error) {
+	return c.feeConfig, nil
+}
+
+func TestWalletEstimateFee(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		key         = secp256k1.TestKeys()[0]
+		addr        = key.Address()
+		avaxAssetID = ids.GenerateTestID()
+		owner       = secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{addr},
+		}
+		feeConfig = &gas.Config{
+			Weights: gas.Dimensions{
+				gas.Bandwidth: 1,
+				gas.DBRead:    10,
+				gas.DBWrite:   100,
+				gas.Compute:   1000,
+			},
+			MinPrice: 1,
+		}
+		builderContext = &pbuilder.Context{
+			NetworkID:         constants.UnitTestID,
+			AVAXAssetID:       avaxAssetID,
+			ComplexityWeights: feeConfig.Weights,
+			GasPrice:          2,
+		}
+		utxos = utxotest.NewDeterministicChainUTXOs(t, map[ids.ID][]*avax.UTXO{
+			constants.PlatformChainID: {
+				{
+					UTXOID: avax.UTXOID{TxID: ids.GenerateTestID()},
+					Asset:  avax.Asset{ID: avaxAssetID},
+					Out: &secp256k1fx.TransferOutput{
+						Amt:          10 * units.Avax,
+						OutputOwners: owner,
+					},
+				},
+			},
+		})
+		backend = pwallet.NewBackend(builderContext, utxos, nil)
+		builder = pbuilder.New(set.Of(addr), builderContext, backend)
+		wallet  = NewWallet(
+			pwallet.New(&testPClient{feeConfig: feeConfig}, builder, nil),
+			nil,
+			nil,
+		)
+	)
+
+	utx, err := builder.NewBaseTx([]*avax.TransferableOutput{{
+		Asset: avax.Asset{ID: avaxAssetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt:          units.Avax,
+			OutputOwners: owner,
+		},
+	}})
+	require.NoError(err)
+
+	var consumed, produced uint64
+	for _, in := range utx.Ins {
+		consumed += in.In.Amount()
+	}
+	for _, out := range utx.Outs {
+		produced += out.Out.Amount()
+	}
+	actualFee := consumed - produced
+
+	estimatedFee, err := wallet.EstimateFee(context.Background(), utx)
+	require.NoError(err)
+	require.Equal(actualFee, estimatedFee)
+}
@@ -709,7 +709,7 @@ func (db *merkleDB) GetRangeProofAtRoot(
 		return nil, database.ErrClosed
 	case maxLength <= 0:
 		return nil, fmt.Errorf("%w but was %d", ErrInvalidMaxLength, maxLength)
-	case rootID == ids.Empty:
+	case rootID.IsZero():
 		return nil, ErrEmptyProof
 	}
 
@@ -736,7 +736,7 @@ func (db *merkleDB) GetChangeProof(
 		return nil, ErrStartAfterEnd
 	case startRootID == endRootID:
 		return nil, errSameRoot
-	case endRootID == ids.Empty:
+	case endRootID.IsZero():
 		return nil, ErrEmptyProof
 	}
 
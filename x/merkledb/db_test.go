@@ -933,7 +933,7 @@ func runRandDBTest(require *require.Assertions, r *rand.Rand, rt randTest, token
 			}
 
 			rangeProof, err := db.GetRangeProofAtRoot(context.Background(), root, start, end, maxProofLen)
-			if root == ids.Empty {
+			if root.IsZero() {
 				require.ErrorIs(err, ErrEmptyProof)
 				continue
 			}
@@ -971,7 +971,7 @@ func runRandDBTest(require *require.Assertions, r *rand.Rand, rt randTest, token
 				require.ErrorIs(err, errSameRoot)
 				continue
 			}
-			if root == ids.Empty {
+			if root.IsZero() {
 				require.ErrorIs(err, ErrEmptyProof)
 				continue
 			}
@@ -1752,7 +1752,7 @@ func FuzzRangeProofInvariants(f *testing.F) {
 			end,
 			int(maxProofLen),
 		)
-		if rootID == ids.Empty {
+		if rootID.IsZero() {
 			require.ErrorIs(err, ErrEmptyProof)
 			return
 		}
@@ -1852,7 +1852,7 @@ func FuzzProofVerification(f *testing.F) {
 			deletePortion,
 		)
 
-		if db.getMerkleRoot() == ids.Empty {
+		if db.getMerkleRoot().IsZero() {
 			return
 		}
 
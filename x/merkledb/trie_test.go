@@ -1002,7 +1002,7 @@ func Test_Trie_MultipleStates(t *testing.T) {
 			for _, state := range concurrentStates {
 				mroot, err := state.GetMerkleRoot(context.Background())
 				require.NoError(err)
-				if pastRoot == ids.Empty {
+				if pastRoot.IsZero() {
 					pastRoot = mroot
 				} else {
 					require.Equal(pastRoot, mroot)
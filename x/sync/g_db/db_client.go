@@ -45,7 +45,7 @@ func (c *DBClient) GetChangeProof(
 	endKey maybe.Maybe[[]byte],
 	keyLimit int,
 ) (*merkledb.ChangeProof, error) {
-	if endRootID == ids.Empty {
+	if endRootID.IsZero() {
 		return nil, merkledb.ErrEmptyProof
 	}
 
@@ -140,7 +140,7 @@ func (c *DBClient) GetRangeProofAtRoot(
 	endKey maybe.Maybe[[]byte],
 	keyLimit int,
 ) (*merkledb.RangeProof, error) {
-	if rootID == ids.Empty {
+	if rootID.IsZero() {
 		return nil, merkledb.ErrEmptyProof
 	}
 
@@ -311,7 +311,7 @@ func (m *Manager) doWork(ctx context.Context, work *workItem) {
 	case <-time.After(waitTime):
 	}
 
-	if work.localRootID == ids.Empty {
+	if work.localRootID.IsZero() {
 		// the keys in this range have not been downloaded, so get all key/values
 		m.requestRangeProof(ctx, work)
 	} else {
@@ -332,7 +332,7 @@ func (m *Manager) requestChangeProof(ctx context.Context, work *workItem) {
 		return
 	}
 
-	if targetRootID == ids.Empty {
+	if targetRootID.IsZero() {
 		defer m.finishWorkItem()
 
 		// The trie is empty after this change.
@@ -393,7 +393,7 @@ func (m *Manager) requestChangeProof(ctx context.Context, work *workItem) {
 func (m *Manager) requestRangeProof(ctx context.Context, work *workItem) {
 	targetRootID := m.getTargetRoot()
 
-	if targetRootID == ids.Empty {
+	if targetRootID.IsZero() {
 		defer m.finishWorkItem()
 
 		if err := m.config.DB.Clear(); err != nil {